@@ -0,0 +1,189 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	ActionType
+ *	A category of invocable actions.
+ *	@since	2.0.0
+ */
+type ActionType string
+
+/*
+ *	Action types.
+ *	@since	2.0.0
+ */
+const (
+	// Standard actions, e.g. postToChatter, emailSimple, or submit.
+	ActionStandard ActionType = "standard"
+
+	// Autolaunched flows.
+	ActionFlow ActionType = "custom/flow"
+
+	// Invocable Apex methods.
+	ActionApex ActionType = "custom/apex"
+
+	// Email alerts.
+	ActionEmailAlert ActionType = "custom/emailAlert"
+)
+
+/*
+ *	Action
+ *	An invocable action listed by ListActions.
+ *	@since	2.0.0
+ */
+type Action struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	Url   string `json:"url"`
+}
+
+/*
+ *	ActionDescribe
+ *	The inputs and outputs of an invocable action.
+ *	@since	2.0.0
+ */
+type ActionDescribe struct {
+	Name    string        `json:"name"`
+	Label   string        `json:"label"`
+	Type    string        `json:"type"`
+	Inputs  []ActionParam `json:"inputs"`
+	Outputs []ActionParam `json:"outputs"`
+}
+
+/*
+ *	ActionParam
+ *	@since	2.0.0
+ */
+type ActionParam struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	SObjectType string `json:"sobjectType"`
+	Required    bool   `json:"required"`
+
+	// 1 for single values, more for collections.
+	MaxOccurs int `json:"maxOccurs"`
+}
+
+/*
+ *	ActionResult
+ *	The result of invoking an action with one of its inputs.
+ *	@since	2.0.0
+ */
+type ActionResult[T any] struct {
+	ActionName   string            `json:"actionName"`
+	IsSuccess    bool              `json:"isSuccess"`
+	Errors       []SalesforceError `json:"errors"`
+	OutputValues T                 `json:"outputValues"`
+
+	// Version of the flow that ran.
+	Version int `json:"version"`
+}
+
+/*
+ *	Err
+ *	Returns the errors reported for the input, or nil if the action succeeded.
+ *	@since	2.0.0
+ */
+func (r *ActionResult[T]) Err() error {
+
+	if r.IsSuccess {
+		return nil
+	}
+
+	return joinErrors(0, r.Errors)
+
+}
+
+/*
+ *	ListActions
+ *	Returns the invocable actions of a type available in the org.
+ *	@since	2.0.0
+ */
+func (c *Client) ListActions(ctx context.Context, actionType ActionType) ([]Action, error) {
+
+	var list struct {
+		Actions []Action `json:"actions"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "actions/"+string(actionType)), nil, &list); err != nil {
+		return nil, err
+	}
+
+	return list.Actions, nil
+
+}
+
+/*
+ *	DescribeAction
+ *	Returns the inputs and outputs of an invocable action, e.g. ActionFlow and the API name of a flow.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeAction(ctx context.Context, actionType ActionType, name string) (*ActionDescribe, error) {
+
+	var describe ActionDescribe
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "actions/"+string(actionType)+"/"+name), nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
+
+/*
+ *	InvokeAction
+ *	Invokes an action once for each input, in a single call, and decodes the output values of each into an Out.
+ *	Inputs are encoded as JSON objects of the input parameters of the action, e.g.
+ *	InvokeAction[map[string]interface{}, map[string]interface{}](ctx, c, ActionFlow, "Close_Case", map[string]interface{}{"caseId": id}).
+ *	The results are returned in the order of the inputs; check the Err of each.
+ *	@since	2.0.0
+ */
+func InvokeAction[In any, Out any](ctx context.Context, c *Client, actionType ActionType, name string, inputs ...In) ([]ActionResult[Out], error) {
+
+	request := map[string]interface{}{"inputs": inputs}
+
+	var results []ActionResult[Out]
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "actions/"+string(actionType)+"/"+name), request, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	InvokeFlow
+ *	Runs an autolaunched flow once for each input, in a single call, as for InvokeAction.
+ *	@since	2.0.0
+ */
+func InvokeFlow[In any, Out any](ctx context.Context, c *Client, flowApiName string, inputs ...In) ([]ActionResult[Out], error) {
+
+	return InvokeAction[In, Out](ctx, c, ActionFlow, flowApiName, inputs...)
+
+}
@@ -0,0 +1,46 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package analytics
+
+// Import standard packages.
+import (
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Client
+ *	A client for the Salesforce Reports and Dashboards REST API and the CRM Analytics REST API.
+ *	A Client is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
+ */
+type Client struct {
+	// Client of the org, used to send requests.
+	client *salesforce.Client
+}
+
+/*
+ *	NewClient
+ *	Returns an analytics client sending requests through the given client,
+ *	with its authentication, API version, and options.
+ *	@since	2.0.0
+ */
+func NewClient(client *salesforce.Client) *Client {
+
+	return &Client{client: client}
+
+}
@@ -0,0 +1,259 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package analytics
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+/*
+ *	Refresh statuses of dashboard components.
+ *	@since	2.0.0
+ */
+const (
+	RefreshIdle    string = "IDLE"
+	RefreshRunning string = "RUNNING"
+)
+
+/*
+ *	DashboardSummary
+ *	A dashboard listed by ListDashboards.
+ *	@since	2.0.0
+ */
+type DashboardSummary struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Url       string `json:"url"`
+	StatusUrl string `json:"statusUrl"`
+}
+
+/*
+ *	DashboardMetadata
+ *	The definition of a dashboard and its components.
+ *	@since	2.0.0
+ */
+type DashboardMetadata struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	DeveloperName string `json:"developerName"`
+	Description   string `json:"description"`
+	FolderId      string `json:"folderId"`
+
+	// User whose access determines the data shown, unless the dashboard is dynamic.
+	RunningUser struct {
+		Id          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"runningUser"`
+
+	Components []DashboardComponent `json:"components"`
+
+	// Positions of the components.
+	Layout json.RawMessage `json:"layout"`
+}
+
+/*
+ *	DashboardComponent
+ *	A chart, table, metric, or gauge of a dashboard, showing the results of a source report.
+ *	@since	2.0.0
+ */
+type DashboardComponent struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Header   string `json:"header"`
+	Title    string `json:"title"`
+	Footer   string `json:"footer"`
+	ReportId string `json:"reportId"`
+
+	// Visualization settings, depending on the type.
+	Properties json.RawMessage `json:"properties"`
+}
+
+/*
+ *	DashboardResult
+ *	The definition of a dashboard and the results of its components, as of their last refresh.
+ *	@since	2.0.0
+ */
+type DashboardResult struct {
+	DashboardMetadata DashboardMetadata `json:"dashboardMetadata"`
+	ComponentData     []ComponentData   `json:"componentData"`
+}
+
+/*
+ *	Component
+ *	Returns the results of the component with the given ID, or nil if the dashboard has no such component.
+ *	@since	2.0.0
+ */
+func (d *DashboardResult) Component(id string) *ComponentData {
+
+	for i := range d.ComponentData {
+		if d.ComponentData[i].ComponentId == id {
+			return &d.ComponentData[i]
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	ComponentData
+ *	The results of a dashboard component: the summaries of its source report, without detail rows.
+ *	@since	2.0.0
+ */
+type ComponentData struct {
+	ComponentId  string          `json:"componentId"`
+	ReportResult *ReportResult   `json:"reportResult"`
+	Status       ComponentStatus `json:"status"`
+}
+
+/*
+ *	ComponentStatus
+ *	@since	2.0.0
+ */
+type ComponentStatus struct {
+	ComponentId   string `json:"componentId"`
+	DataStatus    string `json:"dataStatus"`
+	ErrorMessage  string `json:"errorMessage"`
+	RefreshDate   string `json:"refreshDate"`
+	RefreshStatus string `json:"refreshStatus"`
+}
+
+/*
+ *	ListDashboards
+ *	Returns the dashboards recently viewed by the user.
+ *	@since	2.0.0
+ */
+func (a *Client) ListDashboards(ctx context.Context) ([]DashboardSummary, error) {
+
+	var dashboards []DashboardSummary
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/dashboards", nil, &dashboards); err != nil {
+		return nil, err
+	}
+
+	return dashboards, nil
+
+}
+
+/*
+ *	DescribeDashboard
+ *	Returns the definition of a dashboard.
+ *	@since	2.0.0
+ */
+func (a *Client) DescribeDashboard(ctx context.Context, dashboardId string) (*DashboardMetadata, error) {
+
+	var describe struct {
+		DashboardMetadata DashboardMetadata `json:"dashboardMetadata"`
+	}
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/dashboards/"+dashboardId+"/describe", nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe.DashboardMetadata, nil
+
+}
+
+/*
+ *	GetDashboard
+ *	Returns the results of the components of a dashboard, as of their last refresh.
+ *	@since	2.0.0
+ */
+func (a *Client) GetDashboard(ctx context.Context, dashboardId string) (*DashboardResult, error) {
+
+	var result DashboardResult
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/dashboards/"+dashboardId, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	RefreshDashboard
+ *	Starts refreshing the components of a dashboard. A dashboard can be refreshed once a minute.
+ *	Use DashboardStatus or WaitForDashboardRefresh to follow the refresh.
+ *	@since	2.0.0
+ */
+func (a *Client) RefreshDashboard(ctx context.Context, dashboardId string) error {
+
+	return a.client.DoJSON(ctx, http.MethodPut, "analytics/dashboards/"+dashboardId, nil, nil)
+
+}
+
+/*
+ *	DashboardStatus
+ *	Returns the refresh status of each component of a dashboard.
+ *	@since	2.0.0
+ */
+func (a *Client) DashboardStatus(ctx context.Context, dashboardId string) ([]ComponentStatus, error) {
+
+	var status struct {
+		ComponentStatus []ComponentStatus `json:"componentStatus"`
+	}
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/dashboards/"+dashboardId+"/status", nil, &status); err != nil {
+		return nil, err
+	}
+
+	return status.ComponentStatus, nil
+
+}
+
+/*
+ *	WaitForDashboardRefresh
+ *	Polls the status of a dashboard every interval until none of its components is refreshing,
+ *	and returns the refreshed results.
+ *	@since	2.0.0
+ */
+func (a *Client) WaitForDashboardRefresh(ctx context.Context, dashboardId string, interval time.Duration) (*DashboardResult, error) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := a.DashboardStatus(ctx, dashboardId)
+		if err != nil {
+			return nil, err
+		}
+
+		refreshing := false
+		for _, component := range status {
+			if component.RefreshStatus == RefreshRunning {
+				refreshing = true
+			}
+		}
+
+		if !refreshing {
+			return a.GetDashboard(ctx, dashboardId)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+}
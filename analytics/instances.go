@@ -0,0 +1,170 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package analytics
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+/*
+ *	Statuses of report instances.
+ *	@since	2.0.0
+ */
+const (
+	InstanceNew     string = "New"
+	InstanceRunning string = "Running"
+	InstanceSuccess string = "Success"
+	InstanceError   string = "Error"
+)
+
+/*
+ *	ReportInstance
+ *	An asynchronous run of a report. Results are kept for 24 hours.
+ *	@since	2.0.0
+ */
+type ReportInstance struct {
+	Id             string `json:"id"`
+	ReportId       string `json:"reportId"`
+	Status         string `json:"status"`
+	RequestDate    string `json:"requestDate"`
+	CompletionDate string `json:"completionDate"`
+	OwnerId        string `json:"ownerId"`
+	Url            string `json:"url"`
+	HasDetailRows  bool   `json:"hasDetailRows"`
+}
+
+/*
+ *	ReportInstanceResult
+ *	The state of a report instance and, once it succeeds, its results.
+ *	@since	2.0.0
+ */
+type ReportInstanceResult struct {
+	Attributes ReportInstance `json:"attributes"`
+
+	ReportResult
+}
+
+/*
+ *	CreateReportInstance
+ *	Runs a report asynchronously, with its detail rows, for reports too long to run synchronously.
+ *	The filters are applied in addition to those saved with the report.
+ *	Use WaitForReportInstance or GetReportInstance to obtain the results.
+ *	@since	2.0.0
+ */
+func (a *Client) CreateReportInstance(ctx context.Context, reportId string, filters ...ReportFilter) (*ReportInstance, error) {
+
+	var body interface{}
+
+	if len(filters) > 0 {
+		var err error
+		if body, err = a.filteredMetadata(ctx, reportId, filters); err != nil {
+			return nil, err
+		}
+	}
+
+	var instance ReportInstance
+
+	if err := a.client.DoJSON(ctx, http.MethodPost, "analytics/reports/"+reportId+"/instances?includeDetails=true", body, &instance); err != nil {
+		return nil, err
+	}
+
+	return &instance, nil
+
+}
+
+/*
+ *	ListReportInstances
+ *	Returns the instances of a report requested in the last 24 hours.
+ *	@since	2.0.0
+ */
+func (a *Client) ListReportInstances(ctx context.Context, reportId string) ([]ReportInstance, error) {
+
+	var instances []ReportInstance
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/reports/"+reportId+"/instances", nil, &instances); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+
+}
+
+/*
+ *	GetReportInstance
+ *	Returns the state of a report instance, with its results if it succeeded.
+ *	@since	2.0.0
+ */
+func (a *Client) GetReportInstance(ctx context.Context, reportId string, instanceId string) (*ReportInstanceResult, error) {
+
+	var result ReportInstanceResult
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/reports/"+reportId+"/instances/"+instanceId, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	WaitForReportInstance
+ *	Polls a report instance every interval until it completes, and returns its results.
+ *	Returns an error if the report fails.
+ *	@since	2.0.0
+ */
+func (a *Client) WaitForReportInstance(ctx context.Context, reportId string, instanceId string, interval time.Duration) (*ReportInstanceResult, error) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := a.GetReportInstance(ctx, reportId, instanceId)
+		if err != nil {
+			return nil, err
+		}
+
+		switch result.Attributes.Status {
+		case InstanceSuccess:
+			return result, nil
+		case InstanceError:
+			return result, errors.New("analytics: report instance " + instanceId + " failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+}
+
+/*
+ *	DeleteReportInstance
+ *	Deletes a report instance and its results.
+ *	@since	2.0.0
+ */
+func (a *Client) DeleteReportInstance(ctx context.Context, reportId string, instanceId string) error {
+
+	return a.client.DoJSON(ctx, http.MethodDelete, "analytics/reports/"+reportId+"/instances/"+instanceId, nil, nil)
+
+}
@@ -0,0 +1,328 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package analytics
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+/*
+ *	Report formats.
+ *	@since	2.0.0
+ */
+const (
+	FormatTabular    string = "TABULAR"
+	FormatSummary    string = "SUMMARY"
+	FormatMatrix     string = "MATRIX"
+	FormatMultiBlock string = "MULTI_BLOCK"
+)
+
+/*
+ *	ReportSummary
+ *	A report listed by ListReports.
+ *	@since	2.0.0
+ */
+type ReportSummary struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Url          string `json:"url"`
+	DescribeUrl  string `json:"describeUrl"`
+	InstancesUrl string `json:"instancesUrl"`
+}
+
+/*
+ *	ReportMetadata
+ *	The definition of a report: its format, columns, groupings, and filters.
+ *	@since	2.0.0
+ */
+type ReportMetadata struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	DeveloperName string `json:"developerName"`
+	FolderId      string `json:"folderId"`
+	ReportFormat  string `json:"reportFormat"`
+	ReportType    struct {
+		Type  string `json:"type"`
+		Label string `json:"label"`
+	} `json:"reportType"`
+
+	// API names of the columns of detail rows, e.g. ACCOUNT.NAME.
+	DetailColumns []string `json:"detailColumns"`
+
+	// Summaries computed for each grouping, e.g. s!AMOUNT or RowCount.
+	Aggregates []string `json:"aggregates"`
+
+	GroupingsDown   []GroupingInfo `json:"groupingsDown"`
+	GroupingsAcross []GroupingInfo `json:"groupingsAcross"`
+
+	ReportFilters []ReportFilter `json:"reportFilters"`
+
+	// Logic combining the filters by their 1-based index, e.g. "1 AND (2 OR 3)".
+	ReportBooleanFilter string `json:"reportBooleanFilter"`
+
+	StandardDateFilter *StandardDateFilter `json:"standardDateFilter"`
+
+	Currency       string `json:"currency"`
+	Scope          string `json:"scope"`
+	HasDetailRows  bool   `json:"hasDetailRows"`
+	ShowGrandTotal bool   `json:"showGrandTotal"`
+	ShowSubtotals  bool   `json:"showSubtotals"`
+}
+
+/*
+ *	GroupingInfo
+ *	A grouping of a report, by rows (down) or by columns (across).
+ *	@since	2.0.0
+ */
+type GroupingInfo struct {
+	Name            string `json:"name"`
+	SortOrder       string `json:"sortOrder"`
+	DateGranularity string `json:"dateGranularity"`
+}
+
+/*
+ *	ReportFilter
+ *	A filter of a report, e.g. {Column: "ACCOUNT.TYPE", Operator: "equals", Value: "Customer"}.
+ *	Operators include equals, notEqual, lessThan, greaterThan, lessOrEqual, greaterOrEqual,
+ *	contains, notContain, startsWith, includes, excludes, and within.
+ *	@since	2.0.0
+ */
+type ReportFilter struct {
+	Column   string `json:"column"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+/*
+ *	StandardDateFilter
+ *	The date range of a report, by a named duration, e.g. THIS_FISCAL_QUARTER, or by dates.
+ *	@since	2.0.0
+ */
+type StandardDateFilter struct {
+	Column        string `json:"column"`
+	DurationValue string `json:"durationValue"`
+	StartDate     string `json:"startDate"`
+	EndDate       string `json:"endDate"`
+}
+
+/*
+ *	ReportExtendedMetadata
+ *	The labels and data types of the columns of a report, by API name.
+ *	@since	2.0.0
+ */
+type ReportExtendedMetadata struct {
+	DetailColumnInfo    map[string]ColumnInfo `json:"detailColumnInfo"`
+	AggregateColumnInfo map[string]ColumnInfo `json:"aggregateColumnInfo"`
+	GroupingColumnInfo  map[string]ColumnInfo `json:"groupingColumnInfo"`
+}
+
+/*
+ *	ColumnInfo
+ *	@since	2.0.0
+ */
+type ColumnInfo struct {
+	Label    string `json:"label"`
+	DataType string `json:"dataType"`
+}
+
+/*
+ *	ReportDescribe
+ *	The definition of a report, with the labels of its columns and the fields available from its report type.
+ *	@since	2.0.0
+ */
+type ReportDescribe struct {
+	ReportMetadata         ReportMetadata         `json:"reportMetadata"`
+	ReportExtendedMetadata ReportExtendedMetadata `json:"reportExtendedMetadata"`
+	ReportTypeMetadata     json.RawMessage        `json:"reportTypeMetadata"`
+}
+
+/*
+ *	ReportResult
+ *	The results of a report run. Detail rows and summaries are found in FactMap,
+ *	keyed by the grouping keys down and across, e.g. "0_1!T" for the second subgroup of the first row grouping,
+ *	and "T!T" for the grand total. Use Table for a flat view of the detail rows.
+ *	@since	2.0.0
+ */
+type ReportResult struct {
+	// Whether all detail rows were returned; reports return up to 2,000 detail rows.
+	AllData bool `json:"allData"`
+
+	HasDetailRows   bool               `json:"hasDetailRows"`
+	FactMap         map[string]FactMap `json:"factMap"`
+	GroupingsDown   Groupings          `json:"groupingsDown"`
+	GroupingsAcross Groupings          `json:"groupingsAcross"`
+
+	ReportMetadata         ReportMetadata         `json:"reportMetadata"`
+	ReportExtendedMetadata ReportExtendedMetadata `json:"reportExtendedMetadata"`
+}
+
+/*
+ *	FactMap
+ *	The summaries and detail rows of a grouping of a report.
+ *	@since	2.0.0
+ */
+type FactMap struct {
+	// Summaries in the order of ReportMetadata.Aggregates.
+	Aggregates []ReportCell `json:"aggregates"`
+
+	Rows []struct {
+		// Cells in the order of ReportMetadata.DetailColumns.
+		DataCells []ReportCell `json:"dataCells"`
+	} `json:"rows"`
+}
+
+/*
+ *	ReportCell
+ *	A value of a report, with its formatted label.
+ *	Values are strings, numbers, booleans, or objects, e.g. {"amount": 10, "currency": "USD"} for currencies.
+ *	@since	2.0.0
+ */
+type ReportCell struct {
+	Label string      `json:"label"`
+	Value interface{} `json:"value"`
+}
+
+/*
+ *	Groupings
+ *	@since	2.0.0
+ */
+type Groupings struct {
+	Groupings []GroupingValue `json:"groupings"`
+}
+
+/*
+ *	GroupingValue
+ *	A value of a grouping, with its subgroups. Key identifies the grouping in the FactMap.
+ *	@since	2.0.0
+ */
+type GroupingValue struct {
+	Key       string          `json:"key"`
+	Label     string          `json:"label"`
+	Value     interface{}     `json:"value"`
+	Groupings []GroupingValue `json:"groupings"`
+}
+
+/*
+ *	ListReports
+ *	Returns the reports recently viewed by the user.
+ *	@since	2.0.0
+ */
+func (a *Client) ListReports(ctx context.Context) ([]ReportSummary, error) {
+
+	var reports []ReportSummary
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/reports", nil, &reports); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+
+}
+
+/*
+ *	DescribeReport
+ *	Returns the definition of a report.
+ *	@since	2.0.0
+ */
+func (a *Client) DescribeReport(ctx context.Context, reportId string) (*ReportDescribe, error) {
+
+	var describe ReportDescribe
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/reports/"+reportId+"/describe", nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
+
+/*
+ *	RunReport
+ *	Runs a report synchronously, with its detail rows, and returns its results.
+ *	The filters are applied in addition to those saved with the report; the report itself is not changed.
+ *	Synchronous runs time out after 10 minutes; use CreateReportInstance for longer reports.
+ *	@since	2.0.0
+ */
+func (a *Client) RunReport(ctx context.Context, reportId string, filters ...ReportFilter) (*ReportResult, error) {
+
+	path := "analytics/reports/" + reportId + "?includeDetails=true"
+
+	var result ReportResult
+
+	if len(filters) == 0 {
+		if err := a.client.DoJSON(ctx, http.MethodGet, path, nil, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+
+	body, err := a.filteredMetadata(ctx, reportId, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.client.DoJSON(ctx, http.MethodPost, path, body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	filteredMetadata
+ *	Returns the request body running a report with filters added to its own.
+ *	The metadata is kept as received, so that properties unknown to ReportMetadata are sent back unchanged.
+ *	@since	2.0.0
+ */
+func (a *Client) filteredMetadata(ctx context.Context, reportId string, filters []ReportFilter) (interface{}, error) {
+
+	var describe struct {
+		ReportMetadata map[string]interface{} `json:"reportMetadata"`
+	}
+
+	if err := a.client.DoJSON(ctx, http.MethodGet, "analytics/reports/"+reportId+"/describe", nil, &describe); err != nil {
+		return nil, err
+	}
+
+	var reportFilters []interface{}
+	if existing, ok := describe.ReportMetadata["reportFilters"].([]interface{}); ok {
+		reportFilters = existing
+	}
+
+	for _, filter := range filters {
+		reportFilters = append(reportFilters, filter)
+	}
+
+	describe.ReportMetadata["reportFilters"] = reportFilters
+
+	// Filters combined by boolean logic must be referenced by it, so added filters are ANDed with it.
+	if logic, ok := describe.ReportMetadata["reportBooleanFilter"].(string); ok && logic != "" {
+		for i := len(reportFilters) - len(filters); i < len(reportFilters); i++ {
+			logic = "(" + logic + ") AND " + strconv.Itoa(i+1)
+		}
+		describe.ReportMetadata["reportBooleanFilter"] = logic
+	}
+
+	return map[string]interface{}{"reportMetadata": describe.ReportMetadata}, nil
+
+}
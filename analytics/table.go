@@ -0,0 +1,123 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package analytics
+
+/*
+ *	ReportTable
+ *	The detail rows of a report as a flat table, with the values of the row groupings first.
+ *	@since	2.0.0
+ */
+type ReportTable struct {
+	// Row groupings in the order of ReportMetadata.GroupingsDown, then detail columns.
+	Columns []ReportColumn
+
+	// Cells in the order of Columns.
+	Rows [][]ReportCell
+}
+
+/*
+ *	ReportColumn
+ *	@since	2.0.0
+ */
+type ReportColumn struct {
+	// API name, e.g. ACCOUNT.NAME.
+	Name     string
+	Label    string
+	DataType string
+}
+
+/*
+ *	Table
+ *	Flattens the detail rows of the report, repeating the values of their row groupings on each row.
+ *	Column groupings of matrix reports are not included.
+ *	@since	2.0.0
+ */
+func (r *ReportResult) Table() *ReportTable {
+
+	t := &ReportTable{}
+
+	info := r.ReportExtendedMetadata
+
+	for _, grouping := range r.ReportMetadata.GroupingsDown {
+		column := info.GroupingColumnInfo[grouping.Name]
+		t.Columns = append(t.Columns, ReportColumn{Name: grouping.Name, Label: column.Label, DataType: column.DataType})
+	}
+
+	for _, name := range r.ReportMetadata.DetailColumns {
+		column := info.DetailColumnInfo[name]
+		t.Columns = append(t.Columns, ReportColumn{Name: name, Label: column.Label, DataType: column.DataType})
+	}
+
+	if len(r.GroupingsDown.Groupings) == 0 {
+		r.appendRows(t, "T", nil)
+	} else {
+		r.appendGroupings(t, r.GroupingsDown.Groupings, nil)
+	}
+
+	return t
+
+}
+
+/*
+ *	appendGroupings
+ *	Appends the detail rows of the innermost groupings, prefixed with the values of their enclosing groupings.
+ *	@since	2.0.0
+ */
+func (r *ReportResult) appendGroupings(t *ReportTable, groupings []GroupingValue, prefix []ReportCell) {
+
+	for _, grouping := range groupings {
+		cells := append(prefix[:len(prefix):len(prefix)], ReportCell{Label: grouping.Label, Value: grouping.Value})
+
+		if len(grouping.Groupings) > 0 {
+			r.appendGroupings(t, grouping.Groupings, cells)
+		} else {
+			r.appendRows(t, grouping.Key, cells)
+		}
+	}
+
+}
+
+/*
+ *	appendRows
+ *	Appends the detail rows of the grouping with the given key, prefixed with the given cells.
+ *	@since	2.0.0
+ */
+func (r *ReportResult) appendRows(t *ReportTable, key string, prefix []ReportCell) {
+
+	for _, row := range r.FactMap[key+"!T"].Rows {
+		t.Rows = append(t.Rows, append(prefix[:len(prefix):len(prefix)], row.DataCells...))
+	}
+
+}
+
+/*
+ *	Index
+ *	Returns the index of the column with the given API name, or -1 if the table has no such column.
+ *	@since	2.0.0
+ */
+func (t *ReportTable) Index(name string) int {
+
+	for i, column := range t.Columns {
+		if column.Name == name {
+			return i
+		}
+	}
+
+	return -1
+
+}
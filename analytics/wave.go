@@ -0,0 +1,168 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package analytics
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	SaqlResult
+ *	The results of a SAQL query.
+ *	@since	2.0.0
+ */
+type SaqlResult struct {
+	// Records projected by the query, by field name.
+	Records []map[string]interface{}
+
+	// Milliseconds taken by the query.
+	ResponseTime int
+}
+
+/*
+ *	Dataset
+ *	A CRM Analytics dataset.
+ *	@since	2.0.0
+ */
+type Dataset struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	Label            string `json:"label"`
+	DatasetType      string `json:"datasetType"`
+	CurrentVersionId string `json:"currentVersionId"`
+	Folder           Folder `json:"folder"`
+	CreatedDate      string `json:"createdDate"`
+	LastModifiedDate string `json:"lastModifiedDate"`
+}
+
+/*
+ *	Load
+ *	Returns the SAQL statement loading the current version of the dataset, e.g.
+ *	q = load "0FbXX/0FcXX"; to start a query with.
+ *	@since	2.0.0
+ */
+func (d *Dataset) Load() string {
+
+	return `load "` + d.Id + "/" + d.CurrentVersionId + `"`
+
+}
+
+/*
+ *	Lens
+ *	A saved exploration of CRM Analytics data.
+ *	@since	2.0.0
+ */
+type Lens struct {
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	Label             string `json:"label"`
+	VisualizationType string `json:"visualizationType"`
+	Folder            Folder `json:"folder"`
+	CreatedDate       string `json:"createdDate"`
+	LastModifiedDate  string `json:"lastModifiedDate"`
+}
+
+/*
+ *	Folder
+ *	The app containing a CRM Analytics asset.
+ *	@since	2.0.0
+ */
+type Folder struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+/*
+ *	QuerySaql
+ *	Executes a SAQL query against CRM Analytics datasets, e.g.
+ *	q = load "0FbXX/0FcXX"; q = group q by 'Region'; q = foreach q generate 'Region', count() as 'count';
+ *	@since	2.0.0
+ */
+func (a *Client) QuerySaql(ctx context.Context, saql string) (*SaqlResult, error) {
+
+	var response struct {
+		ResponseTime int `json:"responseTime"`
+		Results      struct {
+			Records []map[string]interface{} `json:"records"`
+		} `json:"results"`
+	}
+
+	if err := a.client.DoJSON(ctx, http.MethodPost, "wave/query", map[string]string{"query": saql}, &response); err != nil {
+		return nil, err
+	}
+
+	return &SaqlResult{Records: response.Results.Records, ResponseTime: response.ResponseTime}, nil
+
+}
+
+/*
+ *	ListDatasets
+ *	Returns the CRM Analytics datasets the user can access, retrieving all pages.
+ *	@since	2.0.0
+ */
+func (a *Client) ListDatasets(ctx context.Context) ([]Dataset, error) {
+
+	var datasets []Dataset
+
+	for path := "wave/datasets"; path != ""; {
+		var page struct {
+			Datasets    []Dataset `json:"datasets"`
+			NextPageUrl string    `json:"nextPageUrl"`
+		}
+
+		if err := a.client.DoJSON(ctx, http.MethodGet, path, nil, &page); err != nil {
+			return nil, err
+		}
+
+		datasets = append(datasets, page.Datasets...)
+		path = page.NextPageUrl
+	}
+
+	return datasets, nil
+
+}
+
+/*
+ *	ListLenses
+ *	Returns the CRM Analytics lenses the user can access, retrieving all pages.
+ *	@since	2.0.0
+ */
+func (a *Client) ListLenses(ctx context.Context) ([]Lens, error) {
+
+	var lenses []Lens
+
+	for path := "wave/lenses"; path != ""; {
+		var page struct {
+			Lenses      []Lens `json:"lenses"`
+			NextPageUrl string `json:"nextPageUrl"`
+		}
+
+		if err := a.client.DoJSON(ctx, http.MethodGet, path, nil, &page); err != nil {
+			return nil, err
+		}
+
+		lenses = append(lenses, page.Lenses...)
+		path = page.NextPageUrl
+	}
+
+	return lenses, nil
+
+}
@@ -0,0 +1,248 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	Approval actions.
+ *	@since	2.0.0
+ */
+const (
+	ApprovalSubmit  string = "Submit"
+	ApprovalApprove string = "Approve"
+	ApprovalReject  string = "Reject"
+
+	// Recalls a submitted record.
+	ApprovalRemove string = "Removed"
+)
+
+/*
+ *	ApprovalProcess
+ *	An approval process, as listed by ApprovalProcesses.
+ *	@since	2.0.0
+ */
+type ApprovalProcess struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Object      string `json:"object"`
+	SortOrder   int    `json:"sortOrder"`
+}
+
+/*
+ *	ApprovalRequest
+ *	A request to submit a record for approval, or to approve, reject, or recall a pending approval.
+ *	@since	2.0.0
+ */
+type ApprovalRequest struct {
+	ActionType string `json:"actionType"`
+
+	// ID of the record to submit, or of the work item to approve, reject, or recall.
+	ContextId string `json:"contextId"`
+
+	Comments string `json:"comments,omitempty"`
+
+	// Approvers of the next step, if chosen manually.
+	NextApproverIds []string `json:"nextApproverIds,omitempty"`
+
+	// Submitter on whose behalf the record is submitted, if not the user.
+	ContextActorId string `json:"contextActorId,omitempty"`
+
+	// Name or ID of the approval process to submit to, if not the first whose entry criteria match.
+	ProcessDefinitionNameOrId string `json:"processDefinitionNameOrId,omitempty"`
+	SkipEntryCriteria         bool   `json:"skipEntryCriteria,omitempty"`
+}
+
+/*
+ *	ApprovalResult
+ *	The result of an approval request.
+ *	@since	2.0.0
+ */
+type ApprovalResult struct {
+	Success        bool              `json:"success"`
+	Errors         []SalesforceError `json:"errors"`
+	EntityId       string            `json:"entityId"`
+	InstanceId     string            `json:"instanceId"`
+	InstanceStatus string            `json:"instanceStatus"`
+	ActorIds       []string          `json:"actorIds"`
+	NewWorkitemIds []string          `json:"newWorkitemIds"`
+}
+
+/*
+ *	Err
+ *	Returns the errors reported for the request, or nil if it succeeded.
+ *	@since	2.0.0
+ */
+func (r *ApprovalResult) Err() error {
+
+	if r.Success {
+		return nil
+	}
+
+	return joinErrors(0, r.Errors)
+
+}
+
+/*
+ *	PendingApproval
+ *	A work item awaiting approval.
+ *	@since	2.0.0
+ */
+type PendingApproval struct {
+	// ID of the work item, to approve or reject.
+	Id string `sf:"Id"`
+
+	ProcessInstanceId string `sf:"ProcessInstanceId"`
+
+	// Record submitted for approval.
+	TargetObjectId string `sf:"ProcessInstance.TargetObjectId"`
+
+	// User or queue assigned to approve.
+	ActorId string `sf:"ActorId"`
+
+	SubmittedById string `sf:"ProcessInstance.SubmittedById"`
+	CreatedDate   string `sf:"CreatedDate"`
+}
+
+/*
+ *	ApprovalProcesses
+ *	Returns the approval processes of the org, by object.
+ *	@since	2.0.0
+ */
+func (c *Client) ApprovalProcesses(ctx context.Context) (map[string][]ApprovalProcess, error) {
+
+	var list struct {
+		Approvals map[string][]ApprovalProcess `json:"approvals"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "process/approvals/"), nil, &list); err != nil {
+		return nil, err
+	}
+
+	return list.Approvals, nil
+
+}
+
+/*
+ *	ProcessApprovals
+ *	Sends approval requests in a single call. The results are returned in the order of the requests.
+ *	@since	2.0.0
+ */
+func (c *Client) ProcessApprovals(ctx context.Context, requests ...ApprovalRequest) ([]ApprovalResult, error) {
+
+	var results []ApprovalResult
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "process/approvals/"), map[string]interface{}{"requests": requests}, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	SubmitForApproval
+ *	Submits a record for approval, with optional comments and approvers of the first step.
+ *	@since	2.0.0
+ */
+func (c *Client) SubmitForApproval(ctx context.Context, recordId string, comments string, nextApproverIds ...string) (*ApprovalResult, error) {
+
+	return c.processApproval(ctx, ApprovalRequest{
+		ActionType:      ApprovalSubmit,
+		ContextId:       recordId,
+		Comments:        comments,
+		NextApproverIds: nextApproverIds,
+	})
+
+}
+
+/*
+ *	Approve
+ *	Approves a pending work item, with optional comments and approvers of the next step.
+ *	@since	2.0.0
+ */
+func (c *Client) Approve(ctx context.Context, workitemId string, comments string, nextApproverIds ...string) (*ApprovalResult, error) {
+
+	return c.processApproval(ctx, ApprovalRequest{
+		ActionType:      ApprovalApprove,
+		ContextId:       workitemId,
+		Comments:        comments,
+		NextApproverIds: nextApproverIds,
+	})
+
+}
+
+/*
+ *	Reject
+ *	Rejects a pending work item, with optional comments.
+ *	@since	2.0.0
+ */
+func (c *Client) Reject(ctx context.Context, workitemId string, comments string) (*ApprovalResult, error) {
+
+	return c.processApproval(ctx, ApprovalRequest{
+		ActionType: ApprovalReject,
+		ContextId:  workitemId,
+		Comments:   comments,
+	})
+
+}
+
+/*
+ *	processApproval
+ *	Sends a single approval request and returns its result, or its errors.
+ *	@since	2.0.0
+ */
+func (c *Client) processApproval(ctx context.Context, request ApprovalRequest) (*ApprovalResult, error) {
+
+	results, err := c.ProcessApprovals(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, errors.New("salesforce: no result for approval request")
+	}
+
+	return &results[0], results[0].Err()
+
+}
+
+/*
+ *	PendingApprovals
+ *	Returns the work items awaiting approval by the given user or queue, oldest first.
+ *	@since	2.0.0
+ */
+func (c *Client) PendingApprovals(ctx context.Context, actorId string) ([]PendingApproval, error) {
+
+	statement, err := soql.Format(`SELECT Id, ProcessInstanceId, ProcessInstance.TargetObjectId, ProcessInstance.SubmittedById, ActorId, CreatedDate
+		FROM ProcessInstanceWorkitem WHERE ActorId = ? ORDER BY CreatedDate`, actorId)
+	if err != nil {
+		return nil, err
+	}
+
+	return QueryInto[PendingApproval](ctx, c, statement)
+
+}
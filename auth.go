@@ -0,0 +1,835 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+/*
+ *	Login URLs shared by all production orgs and all sandboxes.
+ *	@since	2.0.0
+ */
+const (
+	LoginUrlProduction string = "https://login.salesforce.com"
+	LoginUrlSandbox    string = "https://test.salesforce.com"
+)
+
+/*
+ *	Audiences of the JWT bearer flow.
+ *	@since	2.0.0
+ */
+const (
+	AudienceProduction string = LoginUrlProduction
+	AudienceSandbox    string = LoginUrlSandbox
+)
+
+/*
+ *	Token types of the token exchange flow.
+ *	@since	2.0.0
+ */
+const (
+	TokenTypeAccessToken  string = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeRefreshToken string = "urn:ietf:params:oauth:token-type:refresh_token"
+	TokenTypeIdToken      string = "urn:ietf:params:oauth:token-type:id_token"
+	TokenTypeJwt          string = "urn:ietf:params:oauth:token-type:jwt"
+	TokenTypeSaml2        string = "urn:ietf:params:oauth:token-type:saml2"
+)
+
+/*
+ *	Token
+ *	An OAuth 2.0 token response.
+ *	@since	2.0.0
+ */
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	InstanceUrl  string `json:"instance_url"`
+	Id           string `json:"id"`
+	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
+	IssuedAt     string `json:"issued_at"`
+	Signature    string `json:"signature"`
+	IdToken      string `json:"id_token"`
+}
+
+/*
+ *	OAuth2Error
+ *	An error returned by the Salesforce OAuth 2.0 endpoints.
+ *	@since	2.0.0
+ */
+type OAuth2Error struct {
+	ErrorCode   string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *OAuth2Error) Error() string {
+
+	if e.Description == "" {
+		return e.ErrorCode
+	}
+
+	return e.ErrorCode + ": " + e.Description
+
+}
+
+/*
+ *	WithOAuth2Client
+ *	Sets the consumer key and secret of the connected app, used to renew the access token.
+ *	@since	2.0.0
+ */
+func WithOAuth2Client(clientId string, clientSecret string) Option {
+
+	return func(c *Client) {
+		c.clientId = clientId
+		c.clientSecret = clientSecret
+	}
+
+}
+
+/*
+ *	WithRefreshToken
+ *	Sets the OAuth 2.0 refresh token used to renew the access token.
+ *	The client renews the access token transparently when a call is rejected with 401 Unauthorized,
+ *	provided the connected app is set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func WithRefreshToken(refreshToken string) Option {
+
+	return func(c *Client) {
+		c.refreshToken = refreshToken
+	}
+
+}
+
+/*
+ *	postOAuth2
+ *	Posts a form to an OAuth 2.0 endpoint, e.g. "token", and decodes the JSON response into out, if not nil.
+ *	@since	2.0.0
+ */
+func (c *Client) postOAuth2(ctx context.Context, endpoint string, data url.Values, out interface{}) error {
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.loginBaseUrl()+"/services/oauth2/"+endpoint,
+		strings.NewReader(data.Encode()),
+	)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Add("Accept", "application/json")
+	request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := c.send(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		var oauth2Error OAuth2Error
+		if json.Unmarshal(body, &oauth2Error) == nil && oauth2Error.ErrorCode != "" {
+			return &oauth2Error
+		}
+		return decodeError(response.StatusCode, body)
+	}
+
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+
+}
+
+/*
+ *	requestToken
+ *	Requests a token from the OAuth 2.0 token endpoint, and uses it to authorise subsequent calls.
+ *	@since	2.0.0
+ */
+func (c *Client) requestToken(ctx context.Context, data url.Values) (*Token, error) {
+
+	var token Token
+
+	if err := c.postOAuth2(ctx, "token", data, &token); err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("salesforce: no access token returned")
+	}
+
+	c.mu.Lock()
+	c.token = token.TokenType + " " + token.AccessToken
+	if token.RefreshToken != "" {
+		c.refreshToken = token.RefreshToken
+	}
+	if token.Id != "" && token.Id != c.identityUrl {
+		c.identityUrl = token.Id
+		c.identity = nil
+	}
+	if token.InstanceUrl != "" {
+		c.instanceUrl = token.InstanceUrl
+	}
+	c.mu.Unlock()
+
+	// Renewals for the same user keep the identity already resolved.
+	// Resolution is best-effort: the client is authenticated whether or not it succeeds.
+	if c.resolveIdentity && token.Id != "" && c.Identity() == nil {
+		identity, err := c.GetIdentity(ctx)
+		if c.identityCallback != nil {
+			c.identityCallback(identity, err)
+		}
+	}
+
+	return &token, nil
+
+}
+
+/*
+ *	ParseRsaPrivateKey
+ *	Parses a PEM-encoded RSA private key in PKCS #1 or PKCS #8 form.
+ *	@since	2.0.0
+ */
+func ParseRsaPrivateKey(pemData []byte) (*rsa.PrivateKey, error) {
+
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("salesforce: no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("salesforce: private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+
+}
+
+/*
+ *	GetOAuth2AccessTokenJwt
+ *	Obtains an OAuth 2.0 access token using the JWT bearer flow, for server-to-server integrations.
+ *	The assertion is signed with the private key whose certificate is uploaded to the connected app.
+ *	audience is AudienceProduction, AudienceSandbox, or the URL of an Experience Cloud site.
+ *	@since	2.0.0
+ */
+func (c *Client) GetOAuth2AccessTokenJwt(ctx context.Context, consumerKey string, username string, privateKey *rsa.PrivateKey, audience string) (*Token, error) {
+
+	assertion, err := signJwt(privateKey, map[string]interface{}{
+		"iss": consumerKey,
+		"sub": username,
+		"aud": audience,
+		"exp": time.Now().Add(3 * time.Minute).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	data.Set("assertion", assertion)
+
+	token, err := c.requestToken(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setReauthenticate(func(ctx context.Context) error {
+		_, err := c.GetOAuth2AccessTokenJwt(ctx, consumerKey, username, privateKey, audience)
+		return err
+	})
+
+	return token, nil
+
+}
+
+/*
+ *	signJwt
+ *	Returns a JWT with the given claims, signed using RS256.
+ *	@since	2.0.0
+ */
+func signJwt(privateKey *rsa.PrivateKey, claims map[string]interface{}) (string, error) {
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`))
+
+	claimsJson, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(claimsJson)
+
+	hash := sha256.Sum256([]byte(unsigned))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+
+}
+
+/*
+ *	RefreshOAuth2AccessToken
+ *	Obtains a new OAuth 2.0 access token using the refresh token flow.
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) RefreshOAuth2AccessToken(ctx context.Context) (*Token, error) {
+
+	c.mu.RLock()
+	refreshToken := c.refreshToken
+	c.mu.RUnlock()
+
+	if refreshToken == "" {
+		return nil, errors.New("salesforce: no refresh token")
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", refreshToken)
+	data.Set("client_id", c.clientId)
+	if c.clientSecret != "" {
+		data.Set("client_secret", c.clientSecret)
+	}
+
+	return c.requestToken(ctx, data)
+
+}
+
+/*
+ *	renewal
+ *	A token renewal in flight, shared by all calls rejected with the same stale token.
+ *	@since	2.0.0
+ */
+type renewal struct {
+	done chan struct{}
+	err  error
+}
+
+/*
+ *	setReauthenticate
+ *	Records how to repeat the OAuth 2.0 flow that obtained the access token.
+ *	@since	2.0.0
+ */
+func (c *Client) setReauthenticate(reauthenticate func(ctx context.Context) error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reauthenticate = reauthenticate
+
+}
+
+/*
+ *	canRenewToken
+ *	Reports whether the client is able to renew its access token,
+ *	with its refresh token or by repeating the flow that obtained it.
+ *	@since	2.0.0
+ */
+func (c *Client) canRenewToken() bool {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return (c.refreshToken != "" && c.clientId != "") || c.reauthenticate != nil
+
+}
+
+/*
+ *	RenewOAuth2AccessToken
+ *	Renews the access token after a call authorised with rejectedToken, e.g. "Bearer 00D...", was rejected
+ *	in a way the client cannot detect itself, e.g. with gRPC status 16 (Unauthenticated) by the Pub/Sub API.
+ *	Nothing is done if the token has already been renewed since.
+ *	@since	2.0.0
+ */
+func (c *Client) RenewOAuth2AccessToken(ctx context.Context, rejectedToken string) error {
+
+	if !c.canRenewToken() {
+		return errors.New("salesforce: the access token cannot be renewed")
+	}
+
+	return c.renewToken(ctx, rejectedToken)
+
+}
+
+/*
+ *	renewToken
+ *	Renews the access token, unless it has already been renewed since staleToken was rejected.
+ *	Concurrent calls share a single renewal, so a burst of rejected calls
+ *	reaches the token endpoint only once, and all of them see its outcome.
+ *	@since	2.0.0
+ */
+func (c *Client) renewToken(ctx context.Context, staleToken string) error {
+
+	c.renewMu.Lock()
+
+	if c.OAuth2AccessToken() != staleToken {
+		c.renewMu.Unlock()
+		return nil
+	}
+
+	if inFlight := c.renewal; inFlight != nil {
+		c.renewMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-inFlight.done:
+			return inFlight.err
+		}
+	}
+
+	inFlight := &renewal{done: make(chan struct{})}
+	c.renewal = inFlight
+	c.renewMu.Unlock()
+
+	c.mu.RLock()
+	refresh := c.refreshToken != "" && c.clientId != ""
+	reauthenticate := c.reauthenticate
+	c.mu.RUnlock()
+
+	if refresh {
+		_, inFlight.err = c.RefreshOAuth2AccessToken(ctx)
+	} else {
+		inFlight.err = reauthenticate(ctx)
+	}
+
+	c.renewMu.Lock()
+	c.renewal = nil
+	c.renewMu.Unlock()
+
+	close(inFlight.done)
+
+	return inFlight.err
+
+}
+
+/*
+ *	Pkce
+ *	A Proof Key for Code Exchange (PKCE) verifier and its S256 challenge.
+ *	@since	2.0.0
+ */
+type Pkce struct {
+	Verifier  string
+	Challenge string
+}
+
+/*
+ *	NewPkce
+ *	Generates a random PKCE verifier and its challenge.
+ *	@since	2.0.0
+ */
+func NewPkce() (*Pkce, error) {
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+
+	verifier := base64.RawURLEncoding.EncodeToString(random)
+
+	return &Pkce{
+		Verifier:  verifier,
+		Challenge: pkceChallenge(verifier),
+	}, nil
+
+}
+
+/*
+ *	VerifyPkce
+ *	Reports whether challenge is the S256 challenge of verifier.
+ *	@since	2.0.0
+ */
+func VerifyPkce(verifier string, challenge string) bool {
+
+	return subtle.ConstantTimeCompare([]byte(pkceChallenge(verifier)), []byte(challenge)) == 1
+
+}
+
+/*
+ *	pkceChallenge
+ *	@since	2.0.0
+ */
+func pkceChallenge(verifier string) string {
+
+	hash := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+
+}
+
+/*
+ *	AuthorizationUrl
+ *	Returns the URL to send the user to, to start the authorization code flow.
+ *	Salesforce redirects the user back to redirectUri with the code and state.
+ *	pkce may be nil if the connected app does not require PKCE.
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) AuthorizationUrl(redirectUri string, state string, pkce *Pkce, scopes ...string) string {
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", c.clientId)
+	query.Set("redirect_uri", redirectUri)
+
+	if state != "" {
+		query.Set("state", state)
+	}
+
+	if len(scopes) > 0 {
+		query.Set("scope", strings.Join(scopes, " "))
+	}
+
+	if pkce != nil {
+		query.Set("code_challenge", pkce.Challenge)
+		query.Set("code_challenge_method", "S256")
+	}
+
+	return c.loginBaseUrl() + "/services/oauth2/authorize?" + query.Encode()
+
+}
+
+/*
+ *	ExchangeAuthorizationCode
+ *	Obtains OAuth 2.0 access and refresh tokens for the code returned by the authorization code flow.
+ *	pkce must be the one passed to AuthorizationUrl, if any.
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) ExchangeAuthorizationCode(ctx context.Context, code string, redirectUri string, pkce *Pkce) (*Token, error) {
+
+	data := url.Values{}
+	data.Set("grant_type", "authorization_code")
+	data.Set("code", code)
+	data.Set("redirect_uri", redirectUri)
+	data.Set("client_id", c.clientId)
+
+	if c.clientSecret != "" {
+		data.Set("client_secret", c.clientSecret)
+	}
+
+	if pkce != nil {
+		data.Set("code_verifier", pkce.Verifier)
+	}
+
+	return c.requestToken(ctx, data)
+
+}
+
+/*
+ *	DeviceCode
+ *	A device code issued by the device flow.
+ *	Show UserCode and VerificationUri to the user, then call PollDeviceToken.
+ *	@since	2.0.0
+ */
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationUri string `json:"verification_uri"`
+
+	// Minimum number of seconds between polls of the token endpoint.
+	Interval int `json:"interval"`
+}
+
+/*
+ *	RequestDeviceCode
+ *	Starts the device flow, for CLIs and devices that cannot open a browser.
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) RequestDeviceCode(ctx context.Context, scopes ...string) (*DeviceCode, error) {
+
+	data := url.Values{}
+	data.Set("response_type", "device_code")
+	data.Set("client_id", c.clientId)
+
+	if len(scopes) > 0 {
+		data.Set("scope", strings.Join(scopes, " "))
+	}
+
+	var deviceCode DeviceCode
+
+	if err := c.postOAuth2(ctx, "token", data, &deviceCode); err != nil {
+		return nil, err
+	}
+
+	return &deviceCode, nil
+
+}
+
+/*
+ *	PollDeviceToken
+ *	Polls the token endpoint until the user has approved the device code,
+ *	then returns the OAuth 2.0 access and refresh tokens.
+ *	Returns an *OAuth2Error if the user denies access or the code expires.
+ *	@since	2.0.0
+ */
+func (c *Client) PollDeviceToken(ctx context.Context, deviceCode *DeviceCode) (*Token, error) {
+
+	interval := time.Duration(deviceCode.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "device")
+	data.Set("client_id", c.clientId)
+	data.Set("code", deviceCode.DeviceCode)
+
+	if c.clientSecret != "" {
+		data.Set("client_secret", c.clientSecret)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		token, err := c.requestToken(ctx, data)
+
+		var oauth2Error *OAuth2Error
+		if !errors.As(err, &oauth2Error) {
+			return token, err
+		}
+
+		switch oauth2Error.ErrorCode {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		default:
+			return nil, err
+		}
+	}
+
+}
+
+/*
+ *	GetOAuth2AccessTokenPassword
+ *	Obtains an OAuth 2.0 access token using the username-password flow.
+ *	The security token is appended to the password, unless the user logs in from a trusted IP range.
+ *	This flow is intended for legacy orgs and test automation; prefer the JWT bearer or client credentials flows.
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) GetOAuth2AccessTokenPassword(ctx context.Context, username string, password string, securityToken string) (*Token, error) {
+
+	data := url.Values{}
+	data.Set("grant_type", "password")
+	data.Set("client_id", c.clientId)
+	data.Set("client_secret", c.clientSecret)
+	data.Set("username", username)
+	data.Set("password", password+securityToken)
+
+	token, err := c.requestToken(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setReauthenticate(func(ctx context.Context) error {
+		_, err := c.GetOAuth2AccessTokenPassword(ctx, username, password, securityToken)
+		return err
+	})
+
+	return token, nil
+
+}
+
+/*
+ *	GetOAuth2AccessTokenSaml
+ *	Obtains an OAuth 2.0 access token using the SAML 2.0 bearer assertion flow.
+ *	assertion is the signed SAML 2.0 assertion XML issued by the identity provider;
+ *	its certificate must be uploaded to the connected app.
+ *	@since	2.0.0
+ */
+func (c *Client) GetOAuth2AccessTokenSaml(ctx context.Context, assertion []byte) (*Token, error) {
+
+	data := url.Values{}
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:saml2-bearer")
+	data.Set("assertion", base64.RawURLEncoding.EncodeToString(assertion))
+
+	return c.requestToken(ctx, data)
+
+}
+
+/*
+ *	ExchangeToken
+ *	Obtains an OAuth 2.0 access token using the token exchange flow,
+ *	swapping a token issued by an external identity provider for a Salesforce access token
+ *	on behalf of the same user. subjectTokenType is one of the TokenType constants.
+ *	The connected app's token exchange handler maps the subject token to a Salesforce user.
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) ExchangeToken(ctx context.Context, subjectToken string, subjectTokenType string, scopes ...string) (*Token, error) {
+
+	data := url.Values{}
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+	data.Set("client_id", c.clientId)
+	data.Set("subject_token", subjectToken)
+	data.Set("subject_token_type", subjectTokenType)
+	data.Set("requested_token_type", TokenTypeAccessToken)
+
+	if c.clientSecret != "" {
+		data.Set("client_secret", c.clientSecret)
+	}
+
+	if len(scopes) > 0 {
+		data.Set("scope", strings.Join(scopes, " "))
+	}
+
+	return c.requestToken(ctx, data)
+
+}
+
+/*
+ *	RevokeToken
+ *	Revokes an OAuth 2.0 access or refresh token, e.g. on logout or credential rotation.
+ *	Revoking a refresh token also revokes the access tokens issued with it.
+ *	If the token is in use by the client, the client forgets it.
+ *	@since	2.0.0
+ */
+func (c *Client) RevokeToken(ctx context.Context, token string) error {
+
+	data := url.Values{}
+	data.Set("token", token)
+
+	if err := c.postOAuth2(ctx, "revoke", data, nil); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, accessToken, _ := strings.Cut(c.token, " "); accessToken == token {
+		c.token = ""
+	}
+
+	if c.refreshToken == token {
+		c.refreshToken = ""
+	}
+
+	return nil
+
+}
+
+/*
+ *	TokenIntrospection
+ *	The state of an OAuth 2.0 token, as reported by the introspection endpoint.
+ *	@since	2.0.0
+ */
+type TokenIntrospection struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope"`
+	ClientId  string `json:"client_id"`
+	Username  string `json:"username"`
+	Subject   string `json:"sub"`
+	TokenType string `json:"token_type"`
+	Audience  string `json:"aud"`
+	Issuer    string `json:"iss"`
+
+	// Expiry, issue, and not-before times in seconds since the Unix epoch.
+	Exp int64 `json:"exp"`
+	Iat int64 `json:"iat"`
+	Nbf int64 `json:"nbf"`
+}
+
+/*
+ *	Remaining
+ *	Returns the remaining lifetime of the token, or 0 if it is inactive or has expired.
+ *	@since	2.0.0
+ */
+func (t *TokenIntrospection) Remaining() time.Duration {
+
+	if !t.Active || t.Exp == 0 {
+		return 0
+	}
+
+	return max(time.Until(time.Unix(t.Exp, 0)), 0)
+
+}
+
+/*
+ *	IntrospectToken
+ *	Returns the state of an OAuth 2.0 access or refresh token, including its scopes and remaining lifetime.
+ *	tokenTypeHint is "access_token", "refresh_token", or "".
+ *	Requires the connected app to be set with WithOAuth2Client.
+ *	@since	2.0.0
+ */
+func (c *Client) IntrospectToken(ctx context.Context, token string, tokenTypeHint string) (*TokenIntrospection, error) {
+
+	data := url.Values{}
+	data.Set("token", token)
+	data.Set("client_id", c.clientId)
+	data.Set("client_secret", c.clientSecret)
+
+	if tokenTypeHint != "" {
+		data.Set("token_type_hint", tokenTypeHint)
+	}
+
+	var introspection TokenIntrospection
+
+	if err := c.postOAuth2(ctx, "introspect", data, &introspection); err != nil {
+		return nil, err
+	}
+
+	return &introspection, nil
+
+}
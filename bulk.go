@@ -0,0 +1,635 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+/*
+ *	Bulk API 2.0 job states.
+ *	@since	2.0.0
+ */
+const (
+	JobStateOpen           string = "Open"
+	JobStateUploadComplete string = "UploadComplete"
+	JobStateInProgress     string = "InProgress"
+	JobStateJobComplete    string = "JobComplete"
+	JobStateFailed         string = "Failed"
+	JobStateAborted        string = "Aborted"
+)
+
+/*
+ *	BulkJobType
+ *	The kind of a Bulk API 2.0 job.
+ *	@since	2.0.0
+ */
+type BulkJobType string
+
+/*
+ *	Bulk API 2.0 job types.
+ *	@since	2.0.0
+ */
+const (
+	BulkIngest BulkJobType = "ingest"
+	BulkQuery  BulkJobType = "query"
+)
+
+/*
+ *	Bulk API 2.0 operations.
+ *	@since	2.0.0
+ */
+const (
+	OperationQuery    string = "query"
+	OperationQueryAll string = "queryAll"
+
+	OperationInsert string = "insert"
+	OperationUpdate string = "update"
+	OperationUpsert string = "upsert"
+	OperationDelete string = "delete"
+
+	// Deletes records permanently, bypassing the Recycle Bin.
+	// Requires the "Bulk API Hard Delete" permission.
+	OperationHardDelete string = "hardDelete"
+)
+
+/*
+ *	BulkJob
+ *	A Bulk API 2.0 job.
+ *	@since	2.0.0
+ */
+type BulkJob struct {
+	Id                      string  `json:"id"`
+	Operation               string  `json:"operation"`
+	Object                  string  `json:"object"`
+	CreatedById             string  `json:"createdById"`
+	CreatedDate             string  `json:"createdDate"`
+	SystemModstamp          string  `json:"systemModstamp"`
+	State                   string  `json:"state"`
+	ConcurrencyMode         string  `json:"concurrencyMode"`
+	ContentType             string  `json:"contentType"`
+	ApiVersion              float64 `json:"apiVersion"`
+	JobType                 string  `json:"jobType"`
+	LineEnding              string  `json:"lineEnding"`
+	ColumnDelimiter         string  `json:"columnDelimiter"`
+	ExternalIdFieldName     string  `json:"externalIdFieldName"`
+	ContentUrl              string  `json:"contentUrl"`
+	NumberRecordsProcessed  int64   `json:"numberRecordsProcessed"`
+	NumberRecordsFailed     int64   `json:"numberRecordsFailed"`
+	Retries                 int     `json:"retries"`
+	TotalProcessingTime     int64   `json:"totalProcessingTime"`
+	ApiActiveProcessingTime int64   `json:"apiActiveProcessingTime"`
+	ApexProcessingTime      int64   `json:"apexProcessingTime"`
+	ErrorMessage            string  `json:"errorMessage"`
+}
+
+/*
+ *	BulkQueryRequest
+ *	The definition of a Bulk API 2.0 query job.
+ *	@since	2.0.0
+ */
+type BulkQueryRequest struct {
+	// OperationQuery (the default) or OperationQueryAll.
+	Operation string `json:"operation"`
+
+	Query string `json:"query"`
+
+	// BACKQUOTE, CARET, COMMA (the default), PIPE, SEMICOLON, or TAB.
+	ColumnDelimiter string `json:"columnDelimiter,omitempty"`
+
+	// LF (the default) or CRLF.
+	LineEnding string `json:"lineEnding,omitempty"`
+}
+
+/*
+ *	BulkIngestRequest
+ *	The definition of a Bulk API 2.0 ingest job.
+ *	@since	2.0.0
+ */
+type BulkIngestRequest struct {
+	Object string `json:"object"`
+
+	// OperationInsert, OperationUpdate, OperationUpsert, OperationDelete, or OperationHardDelete.
+	Operation string `json:"operation"`
+
+	// Required for OperationUpsert.
+	ExternalIdFieldName string `json:"externalIdFieldName,omitempty"`
+
+	// Assignment rule to apply to Case or Lead records.
+	AssignmentRuleId string `json:"assignmentRuleId,omitempty"`
+
+	// BACKQUOTE, CARET, COMMA (the default), PIPE, SEMICOLON, or TAB.
+	ColumnDelimiter string `json:"columnDelimiter,omitempty"`
+
+	// LF (the default) or CRLF.
+	LineEnding string `json:"lineEnding,omitempty"`
+}
+
+/*
+ *	CreateIngestJob
+ *	Creates a Bulk API 2.0 ingest job.
+ *	Upload the records with UploadJobData, then start processing with CloseJob.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateIngestJob(ctx context.Context, job BulkIngestRequest) (*BulkJob, error) {
+
+	var result BulkJob
+
+	body := struct {
+		BulkIngestRequest
+		ContentType string `json:"contentType"`
+	}{job, "CSV"}
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "jobs/ingest/"), body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	UploadJobData
+ *	Uploads the CSV records of an open Bulk API 2.0 ingest job.
+ *	@since	2.0.0
+ */
+func (c *Client) UploadJobData(ctx context.Context, id string, csv io.Reader) error {
+
+	request, err := c.newRequest(ctx, http.MethodPut, c.dataUrl(ctx, "jobs/ingest/"+id+"/batches"), csv)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "text/csv")
+
+	response, err := c.do(request)
+	if err != nil {
+		return err
+	}
+
+	// 201 Created
+	return response.Body.Close()
+
+}
+
+/*
+ *	CloseJob
+ *	Marks the upload of a Bulk API 2.0 ingest job as complete, so Salesforce starts processing it.
+ *	@since	2.0.0
+ */
+func (c *Client) CloseJob(ctx context.Context, id string) (*BulkJob, error) {
+
+	var result BulkJob
+
+	state := map[string]string{"state": JobStateUploadComplete}
+
+	if err := c.doJSON(ctx, http.MethodPatch, c.dataUrl(ctx, "jobs/ingest/"+id), state, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	HardDelete
+ *	Creates, uploads, and closes a Bulk API 2.0 ingest job that permanently deletes the records
+ *	listed in csv, which must have an Id column. The records bypass the Recycle Bin.
+ *	The job fails if the user lacks the "Bulk API Hard Delete" permission.
+ *	If the upload fails, the job is aborted.
+ *	@since	2.0.0
+ */
+func (c *Client) HardDelete(ctx context.Context, object string, csv io.Reader) (*BulkJob, error) {
+
+	job, err := c.CreateIngestJob(ctx, BulkIngestRequest{
+		Object:    object,
+		Operation: OperationHardDelete,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.UploadJobData(ctx, job.Id, csv); err != nil {
+		// Abort the job rather than leave it open; the upload error is the one that matters.
+		c.AbortJob(context.WithoutCancel(ctx), BulkIngest, job.Id)
+		return nil, err
+	}
+
+	return c.CloseJob(ctx, job.Id)
+
+}
+
+/*
+ *	CreateBulkQueryJob
+ *	Creates a Bulk API 2.0 query job.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateBulkQueryJob(ctx context.Context, job BulkQueryRequest) (*BulkJob, error) {
+
+	if job.Operation == "" {
+		job.Operation = OperationQuery
+	}
+
+	var result BulkJob
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "jobs/query"), job, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	GetBulkQueryJob
+ *	Returns the current state of a Bulk API 2.0 query job.
+ *	@since	2.0.0
+ */
+func (c *Client) GetBulkQueryJob(ctx context.Context, id string) (*BulkJob, error) {
+
+	return c.GetJobInfo(ctx, BulkQuery, id)
+
+}
+
+/*
+ *	WaitForBulkQueryJob
+ *	Polls a Bulk API 2.0 query job every interval until it completes, fails, or is aborted.
+ *	Returns an error if the job fails or is aborted.
+ *	@since	2.0.0
+ */
+func (c *Client) WaitForBulkQueryJob(ctx context.Context, id string, interval time.Duration) (*BulkJob, error) {
+
+	return c.WaitForJob(ctx, BulkQuery, id, interval)
+
+}
+
+/*
+ *	WaitForJob
+ *	Polls a Bulk API 2.0 job every interval until it completes, fails, or is aborted.
+ *	Returns an error if the job fails or is aborted.
+ *	@since	2.0.0
+ */
+func (c *Client) WaitForJob(ctx context.Context, jobType BulkJobType, id string, interval time.Duration) (*BulkJob, error) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := c.GetJobInfo(ctx, jobType, id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.State {
+		case JobStateJobComplete:
+			return job, nil
+		case JobStateFailed, JobStateAborted:
+			return job, errors.New("salesforce: bulk job " + job.State + ": " + job.ErrorMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+}
+
+/*
+ *	ListJobs
+ *	Returns all Bulk API 2.0 jobs of the given type in the org.
+ *	@since	2.0.0
+ */
+func (c *Client) ListJobs(ctx context.Context, jobType BulkJobType) ([]BulkJob, error) {
+
+	var jobs []BulkJob
+
+	url := c.dataUrl(ctx, "jobs/"+string(jobType))
+
+	for {
+		var result struct {
+			Done           bool      `json:"done"`
+			NextRecordsUrl string    `json:"nextRecordsUrl"`
+			Records        []BulkJob `json:"records"`
+		}
+
+		if err := c.doJSON(ctx, http.MethodGet, url, nil, &result); err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, result.Records...)
+
+		if result.Done || result.NextRecordsUrl == "" {
+			return jobs, nil
+		}
+
+		url = c.baseUrl() + result.NextRecordsUrl
+	}
+
+}
+
+/*
+ *	GetJobInfo
+ *	Returns the current state and counters of a Bulk API 2.0 job.
+ *	@since	2.0.0
+ */
+func (c *Client) GetJobInfo(ctx context.Context, jobType BulkJobType, id string) (*BulkJob, error) {
+
+	var result BulkJob
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "jobs/"+string(jobType)+"/"+id), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	AbortJob
+ *	Aborts a Bulk API 2.0 job that has not yet completed.
+ *	@since	2.0.0
+ */
+func (c *Client) AbortJob(ctx context.Context, jobType BulkJobType, id string) (*BulkJob, error) {
+
+	var result BulkJob
+
+	state := map[string]string{"state": JobStateAborted}
+
+	if err := c.doJSON(ctx, http.MethodPatch, c.dataUrl(ctx, "jobs/"+string(jobType)+"/"+id), state, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	DeleteJob
+ *	Deletes a completed, failed, or aborted Bulk API 2.0 job and its data.
+ *	@since	2.0.0
+ */
+func (c *Client) DeleteJob(ctx context.Context, jobType BulkJobType, id string) error {
+
+	return c.doJSON(ctx, http.MethodDelete, c.dataUrl(ctx, "jobs/"+string(jobType)+"/"+id), nil, nil)
+
+}
+
+/*
+ *	bulkQueryResultsPage
+ *	Requests a page of results of a completed Bulk API 2.0 query job.
+ *	Returns the response and the locator of the next page, or "" if this is the last page.
+ *	@since	2.0.0
+ */
+func (c *Client) bulkQueryResultsPage(ctx context.Context, id string, locator string, maxRecords int) (*http.Response, string, error) {
+
+	query := url.Values{}
+	if locator != "" {
+		query.Set("locator", locator)
+	}
+	if maxRecords > 0 {
+		query.Set("maxRecords", strconv.Itoa(maxRecords))
+	}
+
+	path := "jobs/query/" + id + "/results"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	request, err := c.newRequest(ctx, http.MethodGet, c.dataUrl(ctx, path), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	request.Header.Set("Accept", "text/csv")
+
+	response, err := c.do(request)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := response.Header.Get("Sforce-Locator")
+	if next == "null" {
+		next = ""
+	}
+
+	return response, next, nil
+
+}
+
+/*
+ *	BulkQueryResults
+ *	Streams all results of a completed Bulk API 2.0 query job to w as CSV.
+ *	The header row is written once, before the first record.
+ *	maxRecords limits the number of records per page; 0 lets Salesforce decide.
+ *	@since	2.0.0
+ */
+func (c *Client) BulkQueryResults(ctx context.Context, id string, w io.Writer, maxRecords int) error {
+
+	locator := ""
+
+	for page := 0; ; page++ {
+		response, next, err := c.bulkQueryResultsPage(ctx, id, locator, maxRecords)
+		if err != nil {
+			return err
+		}
+
+		reader := bufio.NewReader(response.Body)
+
+		// Every page starts with the header row.
+		if page > 0 {
+			if _, err := reader.ReadString('\n'); err != nil && err != io.EOF {
+				response.Body.Close()
+				return err
+			}
+		}
+
+		_, err = io.Copy(w, reader)
+
+		response.Body.Close()
+
+		if err != nil {
+			return err
+		}
+
+		if next == "" {
+			return nil
+		}
+
+		locator = next
+	}
+
+}
+
+/*
+ *	BulkQueryResultRows
+ *	Streams all results of a completed Bulk API 2.0 query job to rows, one CSV record at a time.
+ *	The header row is sent first. rows is closed when all records have been sent or an error occurs.
+ *	maxRecords limits the number of records per page; 0 lets Salesforce decide.
+ *	@since	2.0.0
+ */
+func (c *Client) BulkQueryResultRows(ctx context.Context, id string, rows chan<- []string, maxRecords int) error {
+
+	defer close(rows)
+
+	job, err := c.GetBulkQueryJob(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	locator := ""
+
+	for page := 0; ; page++ {
+		response, next, err := c.bulkQueryResultsPage(ctx, id, locator, maxRecords)
+		if err != nil {
+			return err
+		}
+
+		err = sendCsvRows(ctx, response.Body, bulkColumnDelimiter(job.ColumnDelimiter), page > 0, rows)
+
+		response.Body.Close()
+
+		if err != nil {
+			return err
+		}
+
+		if next == "" {
+			return nil
+		}
+
+		locator = next
+	}
+
+}
+
+/*
+ *	FailedResults
+ *	Streams the records of a completed Bulk API 2.0 ingest job that failed to w as CSV.
+ *	Each record is prefixed with the sf__Id and sf__Error columns.
+ *	@since	2.0.0
+ */
+func (c *Client) FailedResults(ctx context.Context, id string, w io.Writer) error {
+
+	return c.ingestResults(ctx, id, "failedResults", w)
+
+}
+
+/*
+ *	UnprocessedRecords
+ *	Streams the records of a Bulk API 2.0 ingest job that were not processed to w as CSV,
+ *	e.g. because the job failed or was aborted.
+ *	@since	2.0.0
+ */
+func (c *Client) UnprocessedRecords(ctx context.Context, id string, w io.Writer) error {
+
+	return c.ingestResults(ctx, id, "unprocessedrecords", w)
+
+}
+
+/*
+ *	ingestResults
+ *	@since	2.0.0
+ */
+func (c *Client) ingestResults(ctx context.Context, id string, resource string, w io.Writer) error {
+
+	request, err := c.newRequest(ctx, http.MethodGet, c.dataUrl(ctx, "jobs/ingest/"+id+"/"+resource+"/"), nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "text/csv")
+
+	response, err := c.do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	_, err = io.Copy(w, response.Body)
+
+	return err
+
+}
+
+/*
+ *	sendCsvRows
+ *	Reads CSV records from r and sends them to rows, optionally skipping the header row.
+ *	@since	2.0.0
+ */
+func sendCsvRows(ctx context.Context, r io.Reader, delimiter rune, skipHeader bool, rows chan<- []string) error {
+
+	reader := csv.NewReader(r)
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+
+	for i := 0; ; i++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if i == 0 && skipHeader {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case rows <- row:
+		}
+	}
+
+}
+
+/*
+ *	bulkColumnDelimiter
+ *	Returns the character of a Bulk API 2.0 column delimiter.
+ *	@since	2.0.0
+ */
+func bulkColumnDelimiter(delimiter string) rune {
+
+	switch delimiter {
+	case "BACKQUOTE":
+		return '`'
+	case "CARET":
+		return '^'
+	case "PIPE":
+		return '|'
+	case "SEMICOLON":
+		return ';'
+	case "TAB":
+		return '\t'
+	}
+
+	return ','
+
+}
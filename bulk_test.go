@@ -0,0 +1,78 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+ *	TestHardDeleteAbortsAfterFailedUpload
+ *	A job whose data fails to upload must be aborted rather than left open, and the upload error returned.
+ *	@since	2.0.0
+ */
+func TestHardDeleteAbortsAfterFailedUpload(t *testing.T) {
+
+	var aborted bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/jobs/ingest/"):
+			io.WriteString(w, `{"id": "750000000000001", "state": "Open"}`)
+		case r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `[{"errorCode": "INVALIDJOBSTATE", "message": "Job is not open"}]`)
+		case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, "/jobs/ingest/750000000000001"):
+			var state map[string]string
+			json.NewDecoder(r.Body).Decode(&state)
+			aborted = state["state"] == JobStateAborted
+			io.WriteString(w, `{"id": "750000000000001", "state": "Aborted"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithInstanceUrl(server.URL),
+		WithOAuth2AccessToken("Bearer token"),
+	)
+
+	_, err := client.HardDelete(context.Background(), "Account", strings.NewReader("Id\n001000000000001\n"))
+
+	var sfErr *SalesforceError
+	if !errors.As(err, &sfErr) || sfErr.ErrorCode != "INVALIDJOBSTATE" {
+		t.Errorf("err = %v, want the upload error", err)
+	}
+
+	if !aborted {
+		t.Error("job was not aborted")
+	}
+
+}
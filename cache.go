@@ -0,0 +1,216 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+/*
+ *	DescribeCache
+ *	Stores describe results so they can be revalidated instead of fetched again.
+ *	Implementations must be safe for concurrent use.
+ *	@since	2.0.0
+ */
+type DescribeCache interface {
+	// Returns the entry stored under key, if any.
+	Get(key string) (CachedDescribe, bool)
+
+	// Stores an entry under key.
+	Set(key string, entry CachedDescribe)
+}
+
+/*
+ *	CachedDescribe
+ *	A describe result and the time it was last modified, as an HTTP date.
+ *	@since	2.0.0
+ */
+type CachedDescribe struct {
+	LastModified string
+	Body         []byte
+}
+
+/*
+ *	MemoryDescribeCache
+ *	A DescribeCache held in memory.
+ *	@since	2.0.0
+ */
+type MemoryDescribeCache struct {
+	mu      sync.RWMutex
+	entries map[string]CachedDescribe
+}
+
+/*
+ *	NewMemoryDescribeCache
+ *	Returns an empty DescribeCache held in memory.
+ *	@since	2.0.0
+ */
+func NewMemoryDescribeCache() *MemoryDescribeCache {
+
+	return &MemoryDescribeCache{entries: make(map[string]CachedDescribe)}
+
+}
+
+/*
+ *	Get
+ *	Returns the entry stored under key, if any.
+ *	@since	2.0.0
+ */
+func (m *MemoryDescribeCache) Get(key string) (CachedDescribe, bool) {
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[key]
+
+	return entry, ok
+
+}
+
+/*
+ *	Set
+ *	Stores an entry under key.
+ *	@since	2.0.0
+ */
+func (m *MemoryDescribeCache) Set(key string, entry CachedDescribe) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = entry
+
+}
+
+/*
+ *	Clear
+ *	Removes all entries.
+ *	@since	2.0.0
+ */
+func (m *MemoryDescribeCache) Clear() {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = make(map[string]CachedDescribe)
+
+}
+
+/*
+ *	WithDescribeCache
+ *	Caches describe results, revalidating them with If-Modified-Since on every call.
+ *	Unchanged metadata is then answered with 304 Not Modified and read from the cache.
+ *	@since	2.0.0
+ */
+func WithDescribeCache(cache DescribeCache) Option {
+
+	return func(c *Client) {
+		c.describeCache = cache
+	}
+
+}
+
+/*
+ *	describe
+ *	Sends a GET request to a describe endpoint and decodes the JSON response into out,
+ *	using and updating the describe cache of the client, if any.
+ *	@since	2.0.0
+ */
+func (c *Client) describe(ctx context.Context, url string, out interface{}) error {
+
+	if c.describeCache == nil {
+		return c.doJSON(ctx, http.MethodGet, url, nil, out)
+	}
+
+	request, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	key := c.describeCacheKey(ctx, url)
+
+	cached, ok := c.describeCache.Get(key)
+	if ok && cached.LastModified != "" {
+		request.Header.Set("If-Modified-Since", cached.LastModified)
+	}
+
+	response, err := c.send(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified && ok {
+		return json.Unmarshal(cached.Body, out)
+	}
+
+	if err := checkResponse(response); err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return err
+	}
+
+	// Describe responses carry no Last-Modified header unless the metadata has a known modification time;
+	// the time of the response is as good a validator.
+	lastModified := response.Header.Get("Last-Modified")
+	if lastModified == "" {
+		lastModified = response.Header.Get("Date")
+	}
+
+	c.describeCache.Set(key, CachedDescribe{LastModified: lastModified, Body: body})
+
+	return nil
+
+}
+
+/*
+ *	describeCacheKey
+ *	Returns the cache key of a describe: its URL, which includes the API version,
+ *	and the headers the response varies on, e.g. Accept-Language set with WithLocale or ContextWithLocale.
+ *	@since	2.0.0
+ */
+func (c *Client) describeCacheKey(ctx context.Context, url string) string {
+
+	key := url
+
+	for _, name := range []string{"Accept-Language"} {
+		value := c.header.Get(name)
+		if values := HeadersFromContext(ctx).Values(name); len(values) > 0 {
+			value = strings.Join(values, ", ")
+		}
+		if value != "" {
+			key += "\n" + name + ": " + value
+		}
+	}
+
+	return key
+
+}
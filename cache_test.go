@@ -0,0 +1,79 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+/*
+ *	TestDescribeCacheVariesByLocale
+ *	A describe revalidated for one locale must not be answered with the labels cached for another.
+ *	@since	2.0.0
+ */
+func TestDescribeCacheVariesByLocale(t *testing.T) {
+
+	labels := map[string]string{"en-US": "Account", "fr": "Compte"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The metadata never changes, so every revalidation is answered with 304 Not Modified.
+		if r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		io.WriteString(w, `{"name": "Account", "label": "`+labels[r.Header.Get("Accept-Language")]+`"}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithInstanceUrl(server.URL),
+		WithOAuth2AccessToken("Bearer token"),
+		WithLocale("en-US"),
+		WithDescribeCache(NewMemoryDescribeCache()),
+	)
+
+	tests := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{context.Background(), "Account"},
+		{ContextWithLocale(context.Background(), "fr"), "Compte"},
+		{context.Background(), "Account"},
+		{ContextWithLocale(context.Background(), "fr"), "Compte"},
+	}
+
+	for i, test := range tests {
+		describe, err := client.DescribeSObject(test.ctx, "Account")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if describe.Label != test.want {
+			t.Errorf("describe %d: label = %s, want %s", i, describe.Label, test.want)
+		}
+	}
+
+}
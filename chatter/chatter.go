@@ -0,0 +1,208 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package chatter
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Client
+ *	A client for the Chatter feeds of the Salesforce Connect REST API.
+ *	A Client is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
+ */
+type Client struct {
+	// Client of the org, used to send requests.
+	client *salesforce.Client
+}
+
+/*
+ *	NewClient
+ *	Returns a Chatter client sending requests through the given client,
+ *	with its authentication, API version, and options.
+ *	@since	2.0.0
+ */
+func NewClient(client *salesforce.Client) *Client {
+
+	return &Client{client: client}
+
+}
+
+/*
+ *	FeedItemInput
+ *	A feed item to post.
+ *	@since	2.0.0
+ */
+type FeedItemInput struct {
+	// ID of the record, user, or group whose feed the item is posted to, or "me" for the feed of the user.
+	SubjectId string
+
+	Body []Segment
+
+	// Visibility of the item for external users: AllUsers or InternalUsers.
+	Visibility string
+
+	// IDs of existing ContentDocuments to attach, up to 10, e.g. obtained with salesforce.Client.ContentDocumentId.
+	Files []string
+}
+
+/*
+ *	feedItemRequest
+ *	@since	2.0.0
+ */
+type feedItemRequest struct {
+	FeedElementType string                 `json:"feedElementType"`
+	SubjectId       string                 `json:"subjectId"`
+	Body            messageBody            `json:"body"`
+	Visibility      string                 `json:"visibility,omitempty"`
+	Capabilities    map[string]interface{} `json:"capabilities,omitempty"`
+}
+
+/*
+ *	messageBody
+ *	@since	2.0.0
+ */
+type messageBody struct {
+	MessageSegments []Segment `json:"messageSegments"`
+}
+
+/*
+ *	FeedElement
+ *	A feed item, as returned after posting or when reading a feed.
+ *	@since	2.0.0
+ */
+type FeedElement struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	CreatedDate string `json:"createdDate"`
+	Url         string `json:"url"`
+	Visibility  string `json:"visibility"`
+
+	Body struct {
+		Text            string    `json:"text"`
+		MessageSegments []Segment `json:"messageSegments"`
+	} `json:"body"`
+
+	Actor        Reference              `json:"actor"`
+	Parent       Reference              `json:"parent"`
+	Capabilities map[string]interface{} `json:"capabilities"`
+}
+
+/*
+ *	Reference
+ *	A user, group, or record referenced by a feed element.
+ *	@since	2.0.0
+ */
+type Reference struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	DisplayName string `json:"displayName"`
+	Name        string `json:"name"`
+}
+
+/*
+ *	Post
+ *	Posts a feed item and returns it.
+ *	@since	2.0.0
+ */
+func (c *Client) Post(ctx context.Context, input FeedItemInput) (*FeedElement, error) {
+
+	request := feedItemRequest{
+		FeedElementType: "FeedItem",
+		SubjectId:       input.SubjectId,
+		Body:            messageBody{MessageSegments: input.Body},
+		Visibility:      input.Visibility,
+	}
+
+	if len(input.Files) > 0 {
+		items := make([]map[string]string, len(input.Files))
+		for i, id := range input.Files {
+			items[i] = map[string]string{"id": id}
+		}
+		request.Capabilities = map[string]interface{}{
+			"files": map[string]interface{}{"items": items},
+		}
+	}
+
+	return c.post(ctx, request)
+
+}
+
+/*
+ *	PostText
+ *	Posts a feed item made of the given segments to the feed of a record, user, or group, e.g.
+ *	PostText(ctx, caseId, chatter.Text("Escalated to "), chatter.Mention(userId)).
+ *	@since	2.0.0
+ */
+func (c *Client) PostText(ctx context.Context, subjectId string, segments ...Segment) (*FeedElement, error) {
+
+	return c.Post(ctx, FeedItemInput{SubjectId: subjectId, Body: segments})
+
+}
+
+/*
+ *	post
+ *	@since	2.0.0
+ */
+func (c *Client) post(ctx context.Context, request feedItemRequest) (*FeedElement, error) {
+
+	var element FeedElement
+
+	// 201 Created
+	if err := c.client.DoJSON(ctx, http.MethodPost, "chatter/feed-elements", request, &element); err != nil {
+		return nil, err
+	}
+
+	return &element, nil
+
+}
+
+/*
+ *	RecordFeed
+ *	Returns the most recent feed elements of a record, user, or group.
+ *	@since	2.0.0
+ */
+func (c *Client) RecordFeed(ctx context.Context, subjectId string) ([]FeedElement, error) {
+
+	var page struct {
+		Elements []FeedElement `json:"elements"`
+	}
+
+	if err := c.client.DoJSON(ctx, http.MethodGet, "chatter/feeds/record/"+subjectId+"/feed-elements", nil, &page); err != nil {
+		return nil, err
+	}
+
+	return page.Elements, nil
+
+}
+
+/*
+ *	DeleteFeedElement
+ *	Deletes a feed element.
+ *	@since	2.0.0
+ */
+func (c *Client) DeleteFeedElement(ctx context.Context, id string) error {
+
+	return c.client.DoJSON(ctx, http.MethodDelete, "chatter/feed-elements/"+id, nil, nil)
+
+}
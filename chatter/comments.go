@@ -0,0 +1,163 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package chatter
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	Comment
+ *	A comment on a feed item.
+ *	@since	2.0.0
+ */
+type Comment struct {
+	Id          string `json:"id"`
+	CreatedDate string `json:"createdDate"`
+	Url         string `json:"url"`
+
+	Body struct {
+		Text            string    `json:"text"`
+		MessageSegments []Segment `json:"messageSegments"`
+	} `json:"body"`
+
+	User        Reference `json:"user"`
+	FeedElement Reference `json:"feedElement"`
+}
+
+/*
+ *	Like
+ *	A like of a feed item or comment.
+ *	@since	2.0.0
+ */
+type Like struct {
+	Id        string    `json:"id"`
+	Url       string    `json:"url"`
+	User      Reference `json:"user"`
+	LikedItem Reference `json:"likedItem"`
+}
+
+/*
+ *	PostComment
+ *	Comments on a feed item, optionally attaching an existing ContentDocument, and returns the comment.
+ *	@since	2.0.0
+ */
+func (c *Client) PostComment(ctx context.Context, feedElementId string, contentDocumentId string, segments ...Segment) (*Comment, error) {
+
+	request := map[string]interface{}{
+		"body": messageBody{MessageSegments: segments},
+	}
+
+	if contentDocumentId != "" {
+		request["capabilities"] = map[string]interface{}{
+			"content": map[string]string{"contentDocumentId": contentDocumentId},
+		}
+	}
+
+	var comment Comment
+
+	// 201 Created
+	if err := c.client.DoJSON(ctx, http.MethodPost, "chatter/feed-elements/"+feedElementId+"/capabilities/comments/items", request, &comment); err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+
+}
+
+/*
+ *	Comments
+ *	Returns the most recent comments on a feed item.
+ *	@since	2.0.0
+ */
+func (c *Client) Comments(ctx context.Context, feedElementId string) ([]Comment, error) {
+
+	var page struct {
+		Items []Comment `json:"items"`
+	}
+
+	if err := c.client.DoJSON(ctx, http.MethodGet, "chatter/feed-elements/"+feedElementId+"/capabilities/comments/items", nil, &page); err != nil {
+		return nil, err
+	}
+
+	return page.Items, nil
+
+}
+
+/*
+ *	DeleteComment
+ *	Deletes a comment.
+ *	@since	2.0.0
+ */
+func (c *Client) DeleteComment(ctx context.Context, commentId string) error {
+
+	return c.client.DoJSON(ctx, http.MethodDelete, "chatter/comments/"+commentId, nil, nil)
+
+}
+
+/*
+ *	LikeFeedElement
+ *	Likes a feed item on behalf of the user, and returns the like.
+ *	@since	2.0.0
+ */
+func (c *Client) LikeFeedElement(ctx context.Context, feedElementId string) (*Like, error) {
+
+	return c.like(ctx, "chatter/feed-elements/"+feedElementId+"/capabilities/chatter-likes/items")
+
+}
+
+/*
+ *	LikeComment
+ *	Likes a comment on behalf of the user, and returns the like.
+ *	@since	2.0.0
+ */
+func (c *Client) LikeComment(ctx context.Context, commentId string) (*Like, error) {
+
+	return c.like(ctx, "chatter/comments/"+commentId+"/likes")
+
+}
+
+/*
+ *	like
+ *	@since	2.0.0
+ */
+func (c *Client) like(ctx context.Context, path string) (*Like, error) {
+
+	var like Like
+
+	// 201 Created
+	if err := c.client.DoJSON(ctx, http.MethodPost, path, nil, &like); err != nil {
+		return nil, err
+	}
+
+	return &like, nil
+
+}
+
+/*
+ *	Unlike
+ *	Removes a like of a feed item or comment.
+ *	@since	2.0.0
+ */
+func (c *Client) Unlike(ctx context.Context, likeId string) error {
+
+	return c.client.DoJSON(ctx, http.MethodDelete, "chatter/likes/"+likeId, nil, nil)
+
+}
@@ -0,0 +1,103 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package chatter
+
+/*
+ *	Markup types of rich text segments.
+ *	@since	2.0.0
+ */
+const (
+	MarkupBold          string = "Bold"
+	MarkupItalic        string = "Italic"
+	MarkupUnderline     string = "Underline"
+	MarkupStrikethrough string = "Strikethrough"
+	MarkupParagraph     string = "Paragraph"
+	MarkupCode          string = "Code"
+	MarkupListItem      string = "ListItem"
+	MarkupOrderedList   string = "OrderedList"
+	MarkupUnorderedList string = "UnorderedList"
+)
+
+/*
+ *	Segment
+ *	A segment of the body of a feed item or comment: text, a mention, a link, or rich text markup.
+ *	Hashtags are recognized in text segments.
+ *	@since	2.0.0
+ */
+type Segment struct {
+	// Text, Mention, Link, MarkupBegin, or MarkupEnd.
+	Type string `json:"type"`
+
+	Text string `json:"text,omitempty"`
+
+	// ID of the user or group mentioned.
+	Id string `json:"id,omitempty"`
+
+	Url        string `json:"url,omitempty"`
+	MarkupType string `json:"markupType,omitempty"`
+}
+
+/*
+ *	Text
+ *	Returns a text segment.
+ *	@since	2.0.0
+ */
+func Text(text string) Segment {
+
+	return Segment{Type: "Text", Text: text}
+
+}
+
+/*
+ *	Mention
+ *	Returns a segment @-mentioning a user or group, who is notified of the post.
+ *	@since	2.0.0
+ */
+func Mention(id string) Segment {
+
+	return Segment{Type: "Mention", Id: id}
+
+}
+
+/*
+ *	Link
+ *	Returns a segment linking to a URL.
+ *	@since	2.0.0
+ */
+func Link(url string) Segment {
+
+	return Segment{Type: "Link", Url: url}
+
+}
+
+/*
+ *	Markup
+ *	Returns the given segments enclosed in rich text markup, e.g. Markup(MarkupBold, Text("Urgent")).
+ *	Markups may be nested, e.g. list items in a list.
+ *	@since	2.0.0
+ */
+func Markup(markupType string, segments ...Segment) []Segment {
+
+	enclosed := make([]Segment, 0, len(segments)+2)
+	enclosed = append(enclosed, Segment{Type: "MarkupBegin", MarkupType: markupType})
+	enclosed = append(enclosed, segments...)
+	enclosed = append(enclosed, Segment{Type: "MarkupEnd", MarkupType: markupType})
+
+	return enclosed
+
+}
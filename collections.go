@@ -0,0 +1,168 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+/*
+ *	SaveResult
+ *	The result of saving or deleting a single record.
+ *	@since	2.0.0
+ */
+type SaveResult struct {
+	Id      string            `json:"id"`
+	Success bool              `json:"success"`
+	Created bool              `json:"created"`
+	Errors  []SalesforceError `json:"errors"`
+}
+
+/*
+ *	Err
+ *	Returns the errors reported for the record, or nil if it was saved.
+ *	@since	2.0.0
+ */
+func (r *SaveResult) Err() error {
+
+	if r.Success {
+		return nil
+	}
+
+	return joinErrors(0, r.Errors)
+
+}
+
+/*
+ *	collectionRecords
+ *	Returns copies of the records annotated with their object type, as required by sObject Collections.
+ *	@since	2.0.0
+ */
+func collectionRecords(object string, records []map[string]interface{}) []map[string]interface{} {
+
+	annotated := make([]map[string]interface{}, len(records))
+
+	for i, record := range records {
+		annotated[i] = make(map[string]interface{}, len(record)+1)
+		for field, value := range record {
+			annotated[i][field] = value
+		}
+		annotated[i]["attributes"] = map[string]string{"type": object}
+	}
+
+	return annotated
+
+}
+
+/*
+ *	saveCollection
+ *	@since	2.0.0
+ */
+func (c *Client) saveCollection(ctx context.Context, method string, path string, object string, allOrNone bool, records []map[string]interface{}) ([]SaveResult, error) {
+
+	body := struct {
+		AllOrNone bool                     `json:"allOrNone"`
+		Records   []map[string]interface{} `json:"records"`
+	}{allOrNone, collectionRecords(object, records)}
+
+	var results []SaveResult
+
+	if err := c.doJSON(ctx, method, c.dataUrl(ctx, path), body, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	CollectionsCreate
+ *	Creates up to 200 records of an object in a single call.
+ *	If allOrNone is set, no record is created unless all of them can be.
+ *	The results are returned in the order of the records.
+ *	@since	2.0.0
+ */
+func (c *Client) CollectionsCreate(ctx context.Context, object string, allOrNone bool, records []map[string]interface{}) ([]SaveResult, error) {
+
+	records, err := c.withRecordTypes(ctx, object, records)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.saveCollection(ctx, http.MethodPost, "composite/sobjects", object, allOrNone, records)
+
+}
+
+/*
+ *	CollectionsUpdate
+ *	Updates up to 200 records of an object in a single call. Every record must contain its Id.
+ *	If allOrNone is set, no record is updated unless all of them can be.
+ *	The results are returned in the order of the records.
+ *	@since	2.0.0
+ */
+func (c *Client) CollectionsUpdate(ctx context.Context, object string, allOrNone bool, records []map[string]interface{}) ([]SaveResult, error) {
+
+	return c.saveCollection(ctx, http.MethodPatch, "composite/sobjects", object, allOrNone, records)
+
+}
+
+/*
+ *	CollectionsUpsert
+ *	Creates or updates up to 200 records of an object in a single call, matching them on an external ID field.
+ *	If allOrNone is set, no record is saved unless all of them can be.
+ *	The results are returned in the order of the records; SaveResult.Created reports whether a record was created.
+ *	@since	2.0.0
+ */
+func (c *Client) CollectionsUpsert(ctx context.Context, object string, externalIdField string, allOrNone bool, records []map[string]interface{}) ([]SaveResult, error) {
+
+	records, err := c.withRecordTypes(ctx, object, records)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.saveCollection(ctx, http.MethodPatch, "composite/sobjects/"+object+"/"+externalIdField, object, allOrNone, records)
+
+}
+
+/*
+ *	CollectionsDelete
+ *	Deletes up to 200 records, of any objects, in a single call.
+ *	If allOrNone is set, no record is deleted unless all of them can be.
+ *	The results are returned in the order of the IDs.
+ *	@since	2.0.0
+ */
+func (c *Client) CollectionsDelete(ctx context.Context, allOrNone bool, ids ...string) ([]SaveResult, error) {
+
+	query := url.Values{}
+	query.Set("ids", strings.Join(ids, ","))
+	query.Set("allOrNone", strconv.FormatBool(allOrNone))
+
+	var results []SaveResult
+
+	if err := c.doJSON(ctx, http.MethodDelete, c.dataUrl(ctx, "composite/sobjects?"+query.Encode()), nil, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
@@ -0,0 +1,328 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	CompositeSubrequest
+ *	A subrequest of a Composite request.
+ *	Url may be relative to /services/data/{version}/, e.g. "sobjects/Account".
+ *	Later subrequests can refer to the results of earlier ones with Reference.
+ *	@since	2.0.0
+ */
+type CompositeSubrequest struct {
+	Method      string            `json:"method"`
+	Url         string            `json:"url"`
+	ReferenceId string            `json:"referenceId"`
+	Body        interface{}       `json:"body,omitempty"`
+	HttpHeaders map[string]string `json:"httpHeaders,omitempty"`
+}
+
+/*
+ *	CompositeRequest
+ *	Up to 25 subrequests executed in a single call.
+ *	@since	2.0.0
+ */
+type CompositeRequest struct {
+	// Roll back all subrequests if any of them fails.
+	AllOrNone bool `json:"allOrNone"`
+
+	// Execute independent subrequests in as few transactions as possible.
+	CollateSubrequests bool `json:"collateSubrequests,omitempty"`
+
+	CompositeRequest []CompositeSubrequest `json:"compositeRequest"`
+}
+
+/*
+ *	CompositeSubresponse
+ *	The result of a subrequest of a Composite request.
+ *	@since	2.0.0
+ */
+type CompositeSubresponse struct {
+	Body           json.RawMessage   `json:"body"`
+	HttpHeaders    map[string]string `json:"httpHeaders"`
+	HttpStatusCode int               `json:"httpStatusCode"`
+	ReferenceId    string            `json:"referenceId"`
+}
+
+/*
+ *	Err
+ *	Returns the error reported for the subrequest, or nil if it succeeded.
+ *	@since	2.0.0
+ */
+func (r *CompositeSubresponse) Err() error {
+
+	if r.HttpStatusCode >= 200 && r.HttpStatusCode < 300 {
+		return nil
+	}
+
+	return decodeError(r.HttpStatusCode, r.Body)
+
+}
+
+/*
+ *	Reference
+ *	Returns a reference to a field of the result of an earlier subrequest,
+ *	e.g. Reference("NewAccount", "id") returns "@{NewAccount.id}".
+ *	@since	2.0.0
+ */
+func Reference(referenceId string, field string) string {
+
+	return "@{" + referenceId + "." + field + "}"
+
+}
+
+/*
+ *	Composite
+ *	Executes a series of subrequests in a single call.
+ *	The subresponses are returned in the order of the subrequests.
+ *	@since	2.0.0
+ */
+func (c *Client) Composite(ctx context.Context, composite CompositeRequest) ([]CompositeSubresponse, error) {
+
+	subrequests := make([]CompositeSubrequest, len(composite.CompositeRequest))
+	for i, subrequest := range composite.CompositeRequest {
+		subrequest.Url = c.dataPath(ctx, subrequest.Url)
+		subrequests[i] = subrequest
+	}
+	composite.CompositeRequest = subrequests
+
+	var result struct {
+		CompositeResponse []CompositeSubresponse `json:"compositeResponse"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "composite"), composite, &result); err != nil {
+		return nil, err
+	}
+
+	return result.CompositeResponse, nil
+
+}
+
+/*
+ *	BatchSubrequest
+ *	An independent subrequest of a Composite Batch request.
+ *	Url may be relative to /services/data/{version}/, e.g. "sobjects/Account/001...".
+ *	@since	2.0.0
+ */
+type BatchSubrequest struct {
+	Method    string      `json:"method"`
+	Url       string      `json:"url"`
+	RichInput interface{} `json:"richInput,omitempty"`
+}
+
+/*
+ *	BatchSubresult
+ *	The result of a subrequest of a Composite Batch request.
+ *	@since	2.0.0
+ */
+type BatchSubresult struct {
+	StatusCode int             `json:"statusCode"`
+	Result     json.RawMessage `json:"result"`
+}
+
+/*
+ *	Err
+ *	Returns the error reported for the subrequest, or nil if it succeeded.
+ *	@since	2.0.0
+ */
+func (r *BatchSubresult) Err() error {
+
+	if r.StatusCode >= 200 && r.StatusCode < 300 {
+		return nil
+	}
+
+	return decodeError(r.StatusCode, r.Result)
+
+}
+
+/*
+ *	CompositeBatchResult
+ *	The results of a Composite Batch request, in the order of the subrequests.
+ *	@since	2.0.0
+ */
+type CompositeBatchResult struct {
+	HasErrors bool             `json:"hasErrors"`
+	Results   []BatchSubresult `json:"results"`
+}
+
+/*
+ *	CompositeBatch
+ *	Executes up to 25 independent subrequests in a single call.
+ *	Each subrequest runs in its own transaction. If haltOnError is set,
+ *	the subrequests following a failed one are not executed.
+ *	@since	2.0.0
+ */
+func (c *Client) CompositeBatch(ctx context.Context, haltOnError bool, subrequests ...BatchSubrequest) (*CompositeBatchResult, error) {
+
+	batch := struct {
+		HaltOnError   bool              `json:"haltOnError"`
+		BatchRequests []BatchSubrequest `json:"batchRequests"`
+	}{HaltOnError: haltOnError}
+
+	for _, subrequest := range subrequests {
+		// Batch subrequest URLs are relative to /services/data/.
+		subrequest.Url = strings.TrimPrefix(c.dataPath(ctx, subrequest.Url), "/services/data/")
+		batch.BatchRequests = append(batch.BatchRequests, subrequest)
+	}
+
+	var result CompositeBatchResult
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "composite/batch"), batch, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	Graph
+ *	A graph of up to 500 subrequests, or nodes, executed in a single transaction.
+ *	If any node fails, the whole graph is rolled back.
+ *	@since	2.0.0
+ */
+type Graph struct {
+	GraphId          string                `json:"graphId"`
+	CompositeRequest []CompositeSubrequest `json:"compositeRequest"`
+}
+
+/*
+ *	NewGraph
+ *	Returns an empty graph with the given ID, unique within a Composite Graph request.
+ *	@since	2.0.0
+ */
+func NewGraph(graphId string) *Graph {
+
+	return &Graph{GraphId: graphId}
+
+}
+
+/*
+ *	Add
+ *	Adds a node to the graph.
+ *	@since	2.0.0
+ */
+func (g *Graph) Add(node CompositeSubrequest) *Graph {
+
+	g.CompositeRequest = append(g.CompositeRequest, node)
+	return g
+
+}
+
+/*
+ *	Create
+ *	Adds a node creating a record, and returns a reference to its ID for use by later nodes.
+ *	@since	2.0.0
+ */
+func (g *Graph) Create(referenceId string, object string, data map[string]interface{}) string {
+
+	g.Add(CompositeSubrequest{
+		Method:      http.MethodPost,
+		Url:         "sobjects/" + object,
+		ReferenceId: referenceId,
+		Body:        data,
+	})
+
+	return Reference(referenceId, "id")
+
+}
+
+/*
+ *	Update
+ *	Adds a node updating a record. id may be a reference to an earlier node.
+ *	@since	2.0.0
+ */
+func (g *Graph) Update(referenceId string, object string, id string, data map[string]interface{}) *Graph {
+
+	return g.Add(CompositeSubrequest{
+		Method:      http.MethodPatch,
+		Url:         "sobjects/" + object + "/" + id,
+		ReferenceId: referenceId,
+		Body:        data,
+	})
+
+}
+
+/*
+ *	Delete
+ *	Adds a node deleting a record. id may be a reference to an earlier node.
+ *	@since	2.0.0
+ */
+func (g *Graph) Delete(referenceId string, object string, id string) *Graph {
+
+	return g.Add(CompositeSubrequest{
+		Method:      http.MethodDelete,
+		Url:         "sobjects/" + object + "/" + id,
+		ReferenceId: referenceId,
+	})
+
+}
+
+/*
+ *	GraphResult
+ *	The result of a graph of a Composite Graph request.
+ *	@since	2.0.0
+ */
+type GraphResult struct {
+	GraphId       string `json:"graphId"`
+	IsSuccessful  bool   `json:"isSuccessful"`
+	GraphResponse struct {
+		CompositeResponse []CompositeSubresponse `json:"compositeResponse"`
+	} `json:"graphResponse"`
+}
+
+/*
+ *	CompositeGraph
+ *	Executes independent graphs of subrequests in a single call.
+ *	Each graph succeeds or is rolled back as a whole.
+ *	@since	2.0.0
+ */
+func (c *Client) CompositeGraph(ctx context.Context, graphs ...*Graph) ([]GraphResult, error) {
+
+	body := struct {
+		Graphs []Graph `json:"graphs"`
+	}{}
+
+	for _, graph := range graphs {
+		nodes := make([]CompositeSubrequest, len(graph.CompositeRequest))
+		for i, node := range graph.CompositeRequest {
+			node.Url = c.dataPath(ctx, node.Url)
+			nodes[i] = node
+		}
+		body.Graphs = append(body.Graphs, Graph{GraphId: graph.GraphId, CompositeRequest: nodes})
+	}
+
+	var result struct {
+		Graphs []GraphResult `json:"graphs"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "composite/graph"), body, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Graphs, nil
+
+}
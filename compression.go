@@ -0,0 +1,148 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	WithCompression
+ *	Compresses request bodies of at least minSize bytes with gzip, and asks Salesforce to compress
+ *	responses, which are decompressed transparently. Streamed request bodies are sent uncompressed.
+ *	@since	2.0.0
+ */
+func WithCompression(minSize int) Option {
+
+	return func(c *Client) {
+		c.compression = true
+		c.compressionMinSize = minSize
+	}
+
+}
+
+/*
+ *	gzipMiddleware
+ *	Compresses request bodies and decompresses responses.
+ *	@since	2.0.0
+ */
+func gzipMiddleware(minSize int) Middleware {
+
+	return func(next RoundTripperFunc) RoundTripperFunc {
+		return func(request *http.Request) (*http.Response, error) {
+			if err := compressRequest(request, minSize); err != nil {
+				return nil, err
+			}
+
+			request.Header.Set("Accept-Encoding", "gzip")
+
+			response, err := next(request)
+			if err != nil {
+				return nil, err
+			}
+
+			if !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+				return response, nil
+			}
+
+			reader, err := gzip.NewReader(response.Body)
+			if err != nil {
+				response.Body.Close()
+				return nil, err
+			}
+
+			response.Body = &gzipBody{reader, response.Body}
+			response.Header.Del("Content-Encoding")
+			response.Header.Del("Content-Length")
+			response.ContentLength = -1
+			response.Uncompressed = true
+
+			return response, nil
+		}
+	}
+
+}
+
+/*
+ *	compressRequest
+ *	Compresses the body of a request, if it can be replayed and is large enough.
+ *	@since	2.0.0
+ */
+func compressRequest(request *http.Request, minSize int) error {
+
+	if request.GetBody == nil || request.ContentLength < int64(minSize) || request.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	var compressed bytes.Buffer
+
+	writer := gzip.NewWriter(&compressed)
+	if _, err := io.Copy(writer, body); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	data := compressed.Bytes()
+
+	request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(data))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	request.ContentLength = int64(len(data))
+	request.Header.Set("Content-Encoding", "gzip")
+
+	return nil
+
+}
+
+/*
+ *	gzipBody
+ *	A decompressed response body that closes the underlying body.
+ *	@since	2.0.0
+ */
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+/*
+ *	Close
+ *	@since	2.0.0
+ */
+func (b *gzipBody) Close() error {
+
+	b.Reader.Close()
+
+	return b.body.Close()
+
+}
@@ -0,0 +1,43 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+/*
+ *	ConnectionState
+ *	State of the connection of an event subscriber, reported to its state callback.
+ *	@since	2.0.0
+ */
+type ConnectionState string
+
+/*
+ *	Connection states.
+ *	@since	2.0.0
+ */
+const (
+	// Connecting for the first time.
+	StateConnecting ConnectionState = "Connecting"
+
+	// Connected and receiving events.
+	StateConnected ConnectionState = "Connected"
+
+	// Connection lost; reconnecting after a backoff, resuming after the last event handled.
+	StateReconnecting ConnectionState = "Reconnecting"
+
+	// Stopped, because the context was cancelled or reconnecting failed or was refused.
+	StateDisconnected ConnectionState = "Disconnected"
+)
@@ -0,0 +1,156 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+/*
+ *	Consent actions, checked against the consent records of contacts, leads, users, and person accounts.
+ *	@since	2.0.0
+ */
+const (
+	ConsentEmail             string = "email"
+	ConsentFax               string = "fax"
+	ConsentGeoTrack          string = "geotrack"
+	ConsentMailing           string = "mailing"
+	ConsentPhone             string = "phone"
+	ConsentPortability       string = "portability"
+	ConsentProcess           string = "process"
+	ConsentProfile           string = "profile"
+	ConsentShouldForget      string = "shouldForget"
+	ConsentSocial            string = "social"
+	ConsentSolicit           string = "solicit"
+	ConsentStorePiiElsewhere string = "storePiiElsewhere"
+	ConsentTrack             string = "track"
+	ConsentWeb               string = "web"
+)
+
+/*
+ *	Consent statuses.
+ *	@since	2.0.0
+ */
+const (
+	ConsentOptIn  string = "OptIn"
+	ConsentOptOut string = "OptOut"
+)
+
+/*
+ *	ConsentResult
+ *	Whether an action may proceed for a person, and, if requested, the consent records consulted.
+ *	@since	2.0.0
+ */
+type ConsentResult struct {
+	// Success, or why consent could not be determined, e.g. INVALID_ID.
+	Result string `json:"result"`
+
+	// "true" or "false" by action, with the result for each, e.g. {"email": "true", "emailResult": "Success"}.
+	Proceed map[string]string `json:"proceed"`
+
+	Explanation []struct {
+		ObjectConsulted string `json:"objectConsulted"`
+		Field           string `json:"field"`
+		Value           string `json:"value"`
+		Purpose         string `json:"purpose"`
+		Status          string `json:"status"`
+		RecordId        string `json:"recordId"`
+	} `json:"explanation"`
+}
+
+/*
+ *	Allowed
+ *	Reports whether the person consents to the action.
+ *	@since	2.0.0
+ */
+func (r *ConsentResult) Allowed(action string) bool {
+
+	return r.Proceed[action] == "true"
+
+}
+
+/*
+ *	ConsentUpdate
+ *	The consent of people to an action, recorded by SetConsent.
+ *	@since	2.0.0
+ */
+type ConsentUpdate struct {
+	// ConsentOptIn or ConsentOptOut.
+	Status string `json:"status"`
+
+	// Name of the data use purpose consented to, if any.
+	DataUsePurpose string `json:"dataUsePurpose,omitempty"`
+
+	// When and how consent was captured, e.g. Web or Email.
+	CaptureDate             *time.Time `json:"captureDate,omitempty"`
+	CaptureSource           string     `json:"captureSource,omitempty"`
+	CaptureContactPointType string     `json:"captureContactPointType,omitempty"`
+
+	// Period in which the consent applies.
+	EffectiveFrom *time.Time `json:"effectiveFrom,omitempty"`
+	EffectiveTo   *time.Time `json:"effectiveTo,omitempty"`
+
+	DoubleConsentCaptureDate *time.Time `json:"doubleConsentCaptureDate,omitempty"`
+}
+
+/*
+ *	CheckConsent
+ *	Returns whether each person, identified by record ID or email address, consents to an action, e.g. ConsentEmail,
+ *	for the given data use purpose, if not empty. Consent is aggregated across the records of each person.
+ *	The results are keyed by ID or email address, and include the consent records consulted.
+ *	@since	2.0.0
+ */
+func (c *Client) CheckConsent(ctx context.Context, action string, purpose string, ids ...string) (map[string]ConsentResult, error) {
+
+	query := url.Values{}
+	query.Set("ids", strings.Join(ids, ","))
+	query.Set("verbose", "true")
+
+	if purpose != "" {
+		query.Set("purpose", purpose)
+	}
+
+	var results map[string]ConsentResult
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "consent/action/"+action+"?"+query.Encode()), nil, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	SetConsent
+ *	Records the consent of people, identified by record ID or email address, to the given actions,
+ *	e.g. {ConsentEmail: {Status: ConsentOptOut}}, creating or updating their consent records.
+ *	@since	2.0.0
+ */
+func (c *Client) SetConsent(ctx context.Context, updates map[string]ConsentUpdate, ids ...string) error {
+
+	query := url.Values{}
+	query.Set("ids", strings.Join(ids, ","))
+
+	return c.doJSON(ctx, http.MethodPatch, c.dataUrl(ctx, "consent/action/action?"+query.Encode()), updates, nil)
+
+}
@@ -0,0 +1,149 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	apiVersionKey
+ *	Context key of the API version override.
+ *	@since	2.0.0
+ */
+type apiVersionKey struct{}
+
+/*
+ *	headersKey
+ *	Context key of the additional request headers.
+ *	@since	2.0.0
+ */
+type headersKey struct{}
+
+/*
+ *	ContextWithApiVersion
+ *	Returns a context that makes calls use the given version of the Salesforce REST API, e.g. "v62.0",
+ *	instead of the version of the client.
+ *	@since	2.0.0
+ */
+func ContextWithApiVersion(ctx context.Context, version string) context.Context {
+
+	return context.WithValue(ctx, apiVersionKey{}, version)
+
+}
+
+/*
+ *	ContextWithHeaders
+ *	Returns a context that adds the given headers to every request of calls made with it,
+ *	replacing any values the client would set for the same headers.
+ *	Headers added by enclosing contexts are kept.
+ *	@since	2.0.0
+ */
+func ContextWithHeaders(ctx context.Context, header http.Header) context.Context {
+
+	merged := HeadersFromContext(ctx).Clone()
+	if merged == nil {
+		merged = make(http.Header, len(header))
+	}
+
+	for key, values := range header {
+		merged[http.CanonicalHeaderKey(key)] = values
+	}
+
+	return context.WithValue(ctx, headersKey{}, merged)
+
+}
+
+/*
+ *	ContextWithHeader
+ *	Returns a context that adds the given header to every request of calls made with it.
+ *	@since	2.0.0
+ */
+func ContextWithHeader(ctx context.Context, key string, value string) context.Context {
+
+	return ContextWithHeaders(ctx, http.Header{key: {value}})
+
+}
+
+/*
+ *	HeadersFromContext
+ *	Returns the headers added to requests by the context, or nil if there are none.
+ *	@since	2.0.0
+ */
+func HeadersFromContext(ctx context.Context) http.Header {
+
+	header, _ := ctx.Value(headersKey{}).(http.Header)
+
+	return header
+
+}
+
+/*
+ *	ContextWithAutoAssign
+ *	Returns a context that triggers (the default) or suppresses the active assignment rule
+ *	when Cases and Leads are created or updated with it.
+ *	@since	2.0.0
+ */
+func ContextWithAutoAssign(ctx context.Context, enabled bool) context.Context {
+
+	value := "FALSE"
+	if enabled {
+		value = "TRUE"
+	}
+
+	return ContextWithHeader(ctx, "Sforce-Auto-Assign", value)
+
+}
+
+/*
+ *	ContextWithAssignmentRule
+ *	Returns a context that applies the given assignment rule, rather than the active one,
+ *	when Cases and Leads are created or updated with it.
+ *	@since	2.0.0
+ */
+func ContextWithAssignmentRule(ctx context.Context, ruleId string) context.Context {
+
+	return ContextWithHeader(ctx, "Sforce-Auto-Assign", ruleId)
+
+}
+
+/*
+ *	ContextWithPackageVersion
+ *	Returns a context that pins the behavior of an installed managed package to the given version
+ *	for calls made with it.
+ *	@since	2.0.0
+ */
+func ContextWithPackageVersion(ctx context.Context, namespace string, version string) context.Context {
+
+	return ContextWithHeader(ctx, packageVersionHeader(namespace), version)
+
+}
+
+/*
+ *	ContextWithLocale
+ *	Returns a context that requests labels and error messages in the given language, e.g. "ja-JP",
+ *	for calls made with it.
+ *	@since	2.0.0
+ */
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+
+	return ContextWithHeader(ctx, "Accept-Language", locale)
+
+}
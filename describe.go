@@ -0,0 +1,264 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+/*
+ *	SObjectDescribe
+ *	The metadata of an object: its fields, relationships, and record types.
+ *	@since	2.0.0
+ */
+type SObjectDescribe struct {
+	Name               string              `json:"name"`
+	Label              string              `json:"label"`
+	LabelPlural        string              `json:"labelPlural"`
+	KeyPrefix          string              `json:"keyPrefix"`
+	Custom             bool                `json:"custom"`
+	CustomSetting      bool                `json:"customSetting"`
+	Createable         bool                `json:"createable"`
+	Updateable         bool                `json:"updateable"`
+	Deletable          bool                `json:"deletable"`
+	Undeletable        bool                `json:"undeletable"`
+	Mergeable          bool                `json:"mergeable"`
+	Queryable          bool                `json:"queryable"`
+	Retrieveable       bool                `json:"retrieveable"`
+	Searchable         bool                `json:"searchable"`
+	Replicateable      bool                `json:"replicateable"`
+	Layoutable         bool                `json:"layoutable"`
+	Triggerable        bool                `json:"triggerable"`
+	FeedEnabled        bool                `json:"feedEnabled"`
+	Fields             []Field             `json:"fields"`
+	ChildRelationships []ChildRelationship `json:"childRelationships"`
+	RecordTypeInfos    []RecordTypeInfo    `json:"recordTypeInfos"`
+	Urls               map[string]string   `json:"urls"`
+}
+
+/*
+ *	Field
+ *	Returns the metadata of the field with the given name, or nil if the object has no such field.
+ *	@since	2.0.0
+ */
+func (d *SObjectDescribe) Field(name string) *Field {
+
+	for i := range d.Fields {
+		if strings.EqualFold(d.Fields[i].Name, name) {
+			return &d.Fields[i]
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	Field
+ *	The metadata of a field.
+ *	@since	2.0.0
+ */
+type Field struct {
+	Name                    string          `json:"name"`
+	Label                   string          `json:"label"`
+	Type                    string          `json:"type"`
+	SoapType                string          `json:"soapType"`
+	Length                  int             `json:"length"`
+	ByteLength              int             `json:"byteLength"`
+	Precision               int             `json:"precision"`
+	Scale                   int             `json:"scale"`
+	Digits                  int             `json:"digits"`
+	Custom                  bool            `json:"custom"`
+	Nillable                bool            `json:"nillable"`
+	Createable              bool            `json:"createable"`
+	Updateable              bool            `json:"updateable"`
+	Filterable              bool            `json:"filterable"`
+	Sortable                bool            `json:"sortable"`
+	Groupable               bool            `json:"groupable"`
+	Unique                  bool            `json:"unique"`
+	ExternalId              bool            `json:"externalId"`
+	IdLookup                bool            `json:"idLookup"`
+	NameField               bool            `json:"nameField"`
+	AutoNumber              bool            `json:"autoNumber"`
+	Calculated              bool            `json:"calculated"`
+	CalculatedFormula       string          `json:"calculatedFormula"`
+	CaseSensitive           bool            `json:"caseSensitive"`
+	HtmlFormatted           bool            `json:"htmlFormatted"`
+	Encrypted               bool            `json:"encrypted"`
+	DefaultValue            json.RawMessage `json:"defaultValue"`
+	DefaultedOnCreate       bool            `json:"defaultedOnCreate"`
+	InlineHelpText          string          `json:"inlineHelpText"`
+	PicklistValues          []PicklistValue `json:"picklistValues"`
+	RestrictedPicklist      bool            `json:"restrictedPicklist"`
+	DependentPicklist       bool            `json:"dependentPicklist"`
+	ControllerName          string          `json:"controllerName"`
+	ReferenceTo             []string        `json:"referenceTo"`
+	RelationshipName        string          `json:"relationshipName"`
+	RelationshipOrder       int             `json:"relationshipOrder"`
+	PolymorphicForeignKey   bool            `json:"polymorphicForeignKey"`
+	CascadeDelete           bool            `json:"cascadeDelete"`
+	RestrictedDelete        bool            `json:"restrictedDelete"`
+	WriteRequiresMasterRead bool            `json:"writeRequiresMasterRead"`
+}
+
+/*
+ *	PicklistValue
+ *	A value of a picklist field.
+ *	@since	2.0.0
+ */
+type PicklistValue struct {
+	Active       bool   `json:"active"`
+	DefaultValue bool   `json:"defaultValue"`
+	Label        string `json:"label"`
+	Value        string `json:"value"`
+
+	// For dependent picklists, a base64-encoded bitmap of the controlling values this value is valid for.
+	ValidFor string `json:"validFor"`
+}
+
+/*
+ *	ChildRelationship
+ *	A relationship from another object to the described object.
+ *	@since	2.0.0
+ */
+type ChildRelationship struct {
+	ChildSObject     string `json:"childSObject"`
+	Field            string `json:"field"`
+	RelationshipName string `json:"relationshipName"`
+	CascadeDelete    bool   `json:"cascadeDelete"`
+	RestrictedDelete bool   `json:"restrictedDelete"`
+}
+
+/*
+ *	RecordTypeInfo
+ *	A record type of the described object, as available to the user.
+ *	@since	2.0.0
+ */
+type RecordTypeInfo struct {
+	Name                     string `json:"name"`
+	DeveloperName            string `json:"developerName"`
+	RecordTypeId             string `json:"recordTypeId"`
+	Active                   bool   `json:"active"`
+	Available                bool   `json:"available"`
+	DefaultRecordTypeMapping bool   `json:"defaultRecordTypeMapping"`
+	Master                   bool   `json:"master"`
+}
+
+/*
+ *	DescribeSObject
+ *	Returns the metadata of an object, including its fields, picklist values, and relationships.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeSObject(ctx context.Context, name string) (*SObjectDescribe, error) {
+
+	var describe SObjectDescribe
+
+	if err := c.describe(ctx, c.dataUrl(ctx, "sobjects/"+name+"/describe/"), &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
+
+/*
+ *	GlobalDescribe
+ *	The objects available in the organization.
+ *	@since	2.0.0
+ */
+type GlobalDescribe struct {
+	Encoding     string           `json:"encoding"`
+	MaxBatchSize int              `json:"maxBatchSize"`
+	SObjects     []SObjectSummary `json:"sobjects"`
+}
+
+/*
+ *	SObjectSummary
+ *	The basic metadata of an object as listed by the global describe.
+ *	@since	2.0.0
+ */
+type SObjectSummary struct {
+	Name          string            `json:"name"`
+	Label         string            `json:"label"`
+	LabelPlural   string            `json:"labelPlural"`
+	KeyPrefix     string            `json:"keyPrefix"`
+	Custom        bool              `json:"custom"`
+	CustomSetting bool              `json:"customSetting"`
+	Createable    bool              `json:"createable"`
+	Updateable    bool              `json:"updateable"`
+	Deletable     bool              `json:"deletable"`
+	Queryable     bool              `json:"queryable"`
+	Searchable    bool              `json:"searchable"`
+	Retrieveable  bool              `json:"retrieveable"`
+	Layoutable    bool              `json:"layoutable"`
+	Urls          map[string]string `json:"urls"`
+}
+
+/*
+ *	SObject
+ *	Returns the summary of the object with the given name, or nil if the organization has no such object.
+ *	@since	2.0.0
+ */
+func (d *GlobalDescribe) SObject(name string) *SObjectSummary {
+
+	for i := range d.SObjects {
+		if strings.EqualFold(d.SObjects[i].Name, name) {
+			return &d.SObjects[i]
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	SObjectByKeyPrefix
+ *	Returns the summary of the object whose ids start with the given key prefix, or nil if there is none.
+ *	@since	2.0.0
+ */
+func (d *GlobalDescribe) SObjectByKeyPrefix(prefix string) *SObjectSummary {
+
+	for i := range d.SObjects {
+		if d.SObjects[i].KeyPrefix != "" && d.SObjects[i].KeyPrefix == prefix {
+			return &d.SObjects[i]
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	DescribeGlobal
+ *	Returns the list of objects available in the organization.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeGlobal(ctx context.Context) (*GlobalDescribe, error) {
+
+	var describe GlobalDescribe
+
+	if err := c.describe(ctx, c.dataUrl(ctx, "sobjects/"), &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
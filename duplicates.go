@@ -0,0 +1,214 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*
+ *	DuplicateRuleHeader
+ *	Controls how duplicate rules apply to records created or updated by a call.
+ *	@since	2.0.0
+ */
+type DuplicateRuleHeader struct {
+	// Save records even if duplicate rules match them, when the rules allow it.
+	AllowSave bool
+
+	// Return the fields of the matching records with the duplicate error.
+	IncludeRecordDetails bool
+
+	// Evaluate the sharing rules of the current user when matching records.
+	RunAsCurrentUser bool
+}
+
+/*
+ *	String
+ *	Returns the value of the Sforce-Duplicate-Rule-Header header.
+ *	@since	2.0.0
+ */
+func (h DuplicateRuleHeader) String() string {
+
+	return strings.Join([]string{
+		"allowSave=" + strconv.FormatBool(h.AllowSave),
+		"includeRecordDetails=" + strconv.FormatBool(h.IncludeRecordDetails),
+		"runAsCurrentUser=" + strconv.FormatBool(h.RunAsCurrentUser),
+	}, "; ")
+
+}
+
+/*
+ *	Name of the header controlling duplicate rules.
+ *	@since	2.0.0
+ */
+const duplicateRuleHeader string = "Sforce-Duplicate-Rule-Header"
+
+/*
+ *	ContextWithDuplicateRuleHeader
+ *	Returns a context that applies the duplicate rule header to records created or updated with it.
+ *	@since	2.0.0
+ */
+func ContextWithDuplicateRuleHeader(ctx context.Context, header DuplicateRuleHeader) context.Context {
+
+	return ContextWithHeader(ctx, duplicateRuleHeader, header.String())
+
+}
+
+/*
+ *	WithDuplicateOverride
+ *	Saves records rejected with DUPLICATES_DETECTED when every duplicate rule that matched them allows saving,
+ *	e.g. rules that only alert users, by sending the request again with allowSave=true.
+ *	Applies to records created or updated one at a time, unless the call sets its own duplicate rule header.
+ *	@since	2.0.0
+ */
+func WithDuplicateOverride() Option {
+
+	return func(c *Client) {
+		c.overrideDuplicates = true
+	}
+
+}
+
+/*
+ *	overrideDuplicateRules
+ *	Returns a copy of a request rejected by duplicate rules that allow saving, overriding them,
+ *	if the client is configured to do so and the request can be sent again.
+ *	@since	2.0.0
+ */
+func (c *Client) overrideDuplicateRules(request *http.Request, err error) (*http.Request, bool) {
+
+	if !c.overrideDuplicates || request.Header.Get(duplicateRuleHeader) != "" {
+		return nil, false
+	}
+
+	results := DuplicateResults(err)
+	if len(results) == 0 {
+		return nil, false
+	}
+
+	for _, result := range results {
+		if !result.AllowSave {
+			return nil, false
+		}
+	}
+
+	retry, ok := rewindRequest(request)
+	if !ok {
+		return nil, false
+	}
+
+	retry.Header.Set(duplicateRuleHeader, DuplicateRuleHeader{AllowSave: true}.String())
+
+	return retry, true
+
+}
+
+/*
+ *	DuplicateResults
+ *	Returns the duplicate results of the DUPLICATES_DETECTED errors in err, e.g. returned by Create,
+ *	with the records matched by each duplicate rule.
+ *	@since	2.0.0
+ */
+func DuplicateResults(err error) []*DuplicateResult {
+
+	var errs SalesforceErrors
+	if !errors.As(err, &errs) {
+		var single *SalesforceError
+		if !errors.As(err, &single) {
+			return nil
+		}
+		errs = SalesforceErrors{single}
+	}
+
+	var results []*DuplicateResult
+	for _, e := range errs {
+		if e.DuplicateResult != nil {
+			results = append(results, e.DuplicateResult)
+		}
+	}
+
+	return results
+
+}
+
+/*
+ *	DuplicateResult
+ *	The records matched by a duplicate rule, returned with a DUPLICATES_DETECTED error.
+ *	@since	2.0.0
+ */
+type DuplicateResult struct {
+	AllowSave               bool          `json:"allowSave"`
+	DuplicateRule           string        `json:"duplicateRule"`
+	DuplicateRuleEntityType string        `json:"duplicateRuleEntityType"`
+	ErrorMessage            string        `json:"errorMessage"`
+	MatchResults            []MatchResult `json:"matchResults"`
+}
+
+/*
+ *	MatchResult
+ *	The records matched by a matching rule of a duplicate rule.
+ *	@since	2.0.0
+ */
+type MatchResult struct {
+	EntityType   string        `json:"entityType"`
+	MatchEngine  string        `json:"matchEngine"`
+	Rule         string        `json:"rule"`
+	Size         int           `json:"size"`
+	Success      bool          `json:"success"`
+	MatchRecords []MatchRecord `json:"matchRecords"`
+}
+
+/*
+ *	MatchRecord
+ *	A record matched by a matching rule, and how closely it matches.
+ *	@since	2.0.0
+ */
+type MatchRecord struct {
+	// Confidence of the match, from 0 to 100.
+	MatchConfidence float64 `json:"matchConfidence"`
+
+	// The matching record; its fields are included if IncludeRecordDetails is set.
+	Record json.RawMessage `json:"record"`
+
+	FieldDiffs []struct {
+		Name       string `json:"name"`
+		Difference string `json:"difference"`
+	} `json:"fieldDiffs"`
+
+	AdditionalInformation []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"additionalInformation"`
+}
+
+/*
+ *	Decode
+ *	Decodes the matching record into v, as QueryInto decodes records.
+ *	@since	2.0.0
+ */
+func (r *MatchRecord) Decode(v interface{}) error {
+
+	return decodeRecord(r.Record, v)
+
+}
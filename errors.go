@@ -0,0 +1,333 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	Sentinel errors matched by *SalesforceError through errors.Is.
+ *	@since	2.0.0
+ */
+var (
+	// The requested record does not exist or has been deleted.
+	ErrNotFound = errors.New("salesforce: record not found")
+
+	// The record is locked, e.g. by an approval process or by a concurrent update.
+	ErrLocked = errors.New("salesforce: record locked")
+
+	// The record duplicates an existing record.
+	ErrDuplicate = errors.New("salesforce: duplicate record")
+
+	// A field does not exist, or cannot be set.
+	ErrInvalidField = errors.New("salesforce: invalid field")
+
+	// A required field is missing.
+	ErrRequiredField = errors.New("salesforce: required field missing")
+
+	// A record ID is malformed or refers to a record of the wrong type.
+	ErrInvalidId = errors.New("salesforce: invalid ID")
+
+	// A validation rule rejected the record.
+	ErrValidation = errors.New("salesforce: validation rule failed")
+
+	// The user lacks access to the record or object.
+	ErrInsufficientAccess = errors.New("salesforce: insufficient access")
+
+	// The query is malformed.
+	ErrMalformedQuery = errors.New("salesforce: malformed query")
+
+	// The access token is invalid or has expired.
+	ErrInvalidSession = errors.New("salesforce: invalid session")
+
+	// The org has exceeded an API request limit.
+	ErrRequestLimitExceeded = errors.New("salesforce: request limit exceeded")
+
+	// Salesforce is temporarily unavailable.
+	ErrServerUnavailable = errors.New("salesforce: server unavailable")
+)
+
+/*
+ *	sentinels
+ *	Maps the error codes of Salesforce to sentinel errors.
+ *	@since	2.0.0
+ */
+var sentinels = map[string]error{
+	"NOT_FOUND":                                     ErrNotFound,
+	"ENTITY_IS_DELETED":                             ErrNotFound,
+	"ENTITY_IS_LOCKED":                              ErrLocked,
+	"UNABLE_TO_LOCK_ROW":                            ErrLocked,
+	"DUPLICATE_VALUE":                               ErrDuplicate,
+	"DUPLICATES_DETECTED":                           ErrDuplicate,
+	"DUPLICATE_EXTERNAL_ID":                         ErrDuplicate,
+	"INVALID_FIELD":                                 ErrInvalidField,
+	"INVALID_FIELD_FOR_INSERT_UPDATE":               ErrInvalidField,
+	"INVALID_TYPE_ON_FIELD_IN_RECORD":               ErrInvalidField,
+	"STRING_TOO_LONG":                               ErrInvalidField,
+	"REQUIRED_FIELD_MISSING":                        ErrRequiredField,
+	"MALFORMED_ID":                                  ErrInvalidId,
+	"INVALID_ID_FIELD":                              ErrInvalidId,
+	"INVALID_CROSS_REFERENCE_KEY":                   ErrInvalidId,
+	"FIELD_CUSTOM_VALIDATION_EXCEPTION":             ErrValidation,
+	"INSUFFICIENT_ACCESS":                           ErrInsufficientAccess,
+	"INSUFFICIENT_ACCESS_OR_READONLY":               ErrInsufficientAccess,
+	"INSUFFICIENT_ACCESS_ON_CROSS_REFERENCE_ENTITY": ErrInsufficientAccess,
+	"MALFORMED_QUERY":                               ErrMalformedQuery,
+	"INVALID_QUERY_FILTER_OPERATOR":                 ErrMalformedQuery,
+	"INVALID_SESSION_ID":                            ErrInvalidSession,
+	"REQUEST_LIMIT_EXCEEDED":                        ErrRequestLimitExceeded,
+	"SERVER_UNAVAILABLE":                            ErrServerUnavailable,
+}
+
+/*
+ *	SalesforceError
+ *	An error returned by the Salesforce REST API.
+ *	@since	2.0.0
+ */
+type SalesforceError struct {
+	// HTTP status code of the response.
+	StatusCode int `json:"-"`
+
+	Message   string   `json:"message"`
+	ErrorCode string   `json:"errorCode"`
+	Fields    []string `json:"fields"`
+
+	// Records matched by duplicate rules, for DUPLICATES_DETECTED errors.
+	DuplicateResult *DuplicateResult `json:"duplicateResult,omitempty"`
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *SalesforceError) Error() string {
+
+	if e.ErrorCode == "" {
+		return e.Message
+	}
+
+	if len(e.Fields) > 0 {
+		return e.ErrorCode + ": " + e.Message + " (" + strings.Join(e.Fields, ", ") + ")"
+	}
+
+	return e.ErrorCode + ": " + e.Message
+
+}
+
+/*
+ *	UnmarshalJSON
+ *	Also accepts errors reporting their code as statusCode, as in the results of sObject Collections and actions.
+ *	@since	2.0.0
+ */
+func (e *SalesforceError) UnmarshalJSON(data []byte) error {
+
+	type plain SalesforceError
+
+	var decoded struct {
+		plain
+		StatusCode string `json:"statusCode"`
+	}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*e = SalesforceError(decoded.plain)
+
+	if e.ErrorCode == "" {
+		e.ErrorCode = decoded.StatusCode
+	}
+
+	return nil
+
+}
+
+/*
+ *	Is
+ *	Reports whether the error matches one of the sentinel errors of this package.
+ *	@since	2.0.0
+ */
+func (e *SalesforceError) Is(target error) bool {
+
+	if sentinel, ok := sentinels[e.ErrorCode]; ok {
+		return sentinel == target
+	}
+
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+
+}
+
+/*
+ *	SalesforceErrors
+ *	Several errors returned by the Salesforce REST API for a single call.
+ *	errors.Is and errors.As examine each of them.
+ *	@since	2.0.0
+ */
+type SalesforceErrors []*SalesforceError
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e SalesforceErrors) Error() string {
+
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+
+}
+
+/*
+ *	Unwrap
+ *	@since	2.0.0
+ */
+func (e SalesforceErrors) Unwrap() []error {
+
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+
+}
+
+/*
+ *	joinErrors
+ *	Returns nil for no errors, a *SalesforceError for one, and SalesforceErrors for several.
+ *	@since	2.0.0
+ */
+func joinErrors(statusCode int, errs []SalesforceError) error {
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		errs[0].StatusCode = statusCode
+		return &errs[0]
+	}
+
+	joined := make(SalesforceErrors, len(errs))
+	for i := range errs {
+		errs[i].StatusCode = statusCode
+		joined[i] = &errs[i]
+	}
+
+	return joined
+
+}
+
+/*
+ *	checkResponse
+ *	Returns nil if the response has a 2xx status code.
+ *	Otherwise, reads the error body returned by Salesforce and returns it as a *SalesforceError.
+ *	@since	2.0.0
+ */
+func checkResponse(response *http.Response) error {
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(response.Body)
+
+	return decodeError(response.StatusCode, body)
+
+}
+
+/*
+ *	decodeError
+ *	Decodes an error body returned by Salesforce with the given HTTP status code
+ *	into a *SalesforceError, or SalesforceErrors if it holds several errors.
+ *	@since	2.0.0
+ */
+func decodeError(statusCode int, body []byte) error {
+
+	var errs []SalesforceError
+
+	if json.Unmarshal(body, &errs) == nil && len(errs) > 0 {
+		return joinErrors(statusCode, errs)
+	}
+
+	if err := decodeSoapFault(statusCode, body); err != nil {
+		return err
+	}
+
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode))
+	}
+
+	return &SalesforceError{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+
+}
+
+/*
+ *	decodeSoapFault
+ *	Decodes a SOAP fault returned by the SOAP and Metadata APIs into a *SalesforceError,
+ *	or returns nil if the body is not a SOAP fault.
+ *	@since	2.0.0
+ */
+func decodeSoapFault(statusCode int, body []byte) error {
+
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("<")) {
+		return nil
+	}
+
+	var envelope struct {
+		Body struct {
+			Fault struct {
+				Code   string `xml:"faultcode"`
+				String string `xml:"faultstring"`
+			} `xml:"Fault"`
+		} `xml:"Body"`
+	}
+
+	if xml.Unmarshal(body, &envelope) != nil || envelope.Body.Fault.Code == "" {
+		return nil
+	}
+
+	// Fault codes are qualified, e.g. sf:INVALID_SESSION_ID.
+	code := envelope.Body.Fault.Code
+	if i := strings.LastIndex(code, ":"); i >= 0 {
+		code = code[i+1:]
+	}
+
+	// Fault strings repeat the code, e.g. INVALID_SESSION_ID: Invalid Session ID found.
+	return &SalesforceError{
+		StatusCode: statusCode,
+		Message:    strings.TrimPrefix(envelope.Body.Fault.String, code+": "),
+		ErrorCode:  code,
+	}
+
+}
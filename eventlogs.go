@@ -0,0 +1,261 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	EventLogFile
+ *	A daily or hourly log of the events of a type, e.g. Login, API, or ReportExport, kept by Event Monitoring.
+ *	@since	2.0.0
+ */
+type EventLogFile struct {
+	Id        string `sf:"Id"`
+	EventType string `sf:"EventType"`
+	LogDate   string `sf:"LogDate"`
+
+	// Daily or Hourly; hourly logs of the same hour may be split by Sequence.
+	Interval string `sf:"Interval"`
+	Sequence int    `sf:"Sequence"`
+
+	// Size of the log in bytes.
+	LogFileLength float64 `sf:"LogFileLength"`
+
+	// Comma-separated names and types of the columns of the log.
+	LogFileFieldNames string `sf:"LogFileFieldNames"`
+	LogFileFieldTypes string `sf:"LogFileFieldTypes"`
+}
+
+/*
+ *	EventLogFiles
+ *	Returns the event log files of a type, e.g. Login, or of all types if eventType is empty,
+ *	logged since the given time, oldest first.
+ *	@since	2.0.0
+ */
+func (c *Client) EventLogFiles(ctx context.Context, eventType string, since time.Time) ([]EventLogFile, error) {
+
+	query := `SELECT Id, EventType, LogDate, Interval, Sequence, LogFileLength, LogFileFieldNames, LogFileFieldTypes
+		FROM EventLogFile WHERE LogDate >= ?`
+	args := []interface{}{since}
+
+	if eventType != "" {
+		query += " AND EventType = ?"
+		args = append(args, eventType)
+	}
+
+	statement, err := soql.Format(query+" ORDER BY LogDate, Sequence", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return QueryInto[EventLogFile](ctx, c, statement)
+
+}
+
+/*
+ *	DownloadEventLogFile
+ *	Streams the CSV content of an event log file, which may be hundreds of megabytes.
+ *	The caller must close the returned reader.
+ *	@since	2.0.0
+ */
+func (c *Client) DownloadEventLogFile(ctx context.Context, id string) (io.ReadCloser, error) {
+
+	return c.DownloadBlob(ctx, "EventLogFile", id, "LogFile")
+
+}
+
+/*
+ *	EventLogRows
+ *	Streams the rows of an event log file, decoding each into a T and calling fn with it, until fn returns an error.
+ *	T may be a map[string]string of the values by column, or a struct whose fields are matched to columns
+ *	by their sf tags, e.g. `sf:"USER_ID"`, or their names, ignoring case and underscores.
+ *	Fields of type string, bool, int, float, and time.Time, parsed from TIMESTAMP or ISO 8601 values, are supported; empty values are left as zero.
+ *	@since	2.0.0
+ */
+func EventLogRows[T any](ctx context.Context, c *Client, id string, fn func(row T) error) error {
+
+	body, err := c.DownloadEventLogFile(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	reader := csv.NewReader(body)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	header = append([]string(nil), header...)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var row T
+		if err := decodeCsvRow(header, record, &row); err != nil {
+			return err
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+}
+
+/*
+ *	decodeCsvRow
+ *	Decodes the values of a CSV record into v, a pointer to a map[string]string or a struct.
+ *	@since	2.0.0
+ */
+func decodeCsvRow(header []string, record []string, v interface{}) error {
+
+	rv := reflect.ValueOf(v).Elem()
+
+	if m, ok := v.(*map[string]string); ok {
+		*m = make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				(*m)[name] = record[i]
+			}
+		}
+		return nil
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return errors.New("salesforce: cannot decode event log rows into " + rv.Type().String())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[csvColumnKey(name)] = i
+	}
+
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("sf"); ok {
+			name, _, _ = strings.Cut(tag, ",")
+		}
+
+		column, ok := columns[csvColumnKey(name)]
+		if !ok || column >= len(record) || record[column] == "" {
+			continue
+		}
+
+		if err := setCsvValue(rv.Field(i), record[column]); err != nil {
+			return errors.New("salesforce: invalid value of " + header[column] + ": " + err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	csvColumnKey
+ *	Normalizes the name of a column, e.g. USER_ID, or of a field, e.g. UserId, for matching.
+ *	@since	2.0.0
+ */
+func csvColumnKey(name string) string {
+
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+
+}
+
+// Layout of the TIMESTAMP column of event log files.
+const eventLogTimestampLayout = "20060102150405.000"
+
+/*
+ *	setCsvValue
+ *	Parses a CSV value into a struct field.
+ *	@since	2.0.0
+ */
+func setCsvValue(field reflect.Value, value string) error {
+
+	if _, ok := field.Interface().(time.Time); ok {
+		// TIMESTAMP columns are written as yyyyMMddHHmmss.SSS in UTC, TIMESTAMP_DERIVED columns as ISO 8601.
+		t, err := time.Parse(eventLogTimestampLayout, value)
+		if err != nil {
+			t, err = time.Parse(time.RFC3339Nano, value)
+		}
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return errors.New("unsupported field type " + field.Type().String())
+	}
+
+	return nil
+
+}
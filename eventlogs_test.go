@@ -0,0 +1,77 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+/*
+ *	TestEventLogRowsTimestamps
+ *	TIMESTAMP and TIMESTAMP_DERIVED columns must both decode into time.Time fields.
+ *	@since	2.0.0
+ */
+func TestEventLogRowsTimestamps(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		io.WriteString(w, "\"EVENT_TYPE\",\"TIMESTAMP\",\"TIMESTAMP_DERIVED\"\n\"API\",\"20240102030405.123\",\"2024-01-02T03:04:05.123Z\"\n")
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithInstanceUrl(server.URL),
+		WithOAuth2AccessToken("Bearer token"),
+	)
+
+	type row struct {
+		EventType        string
+		Timestamp        time.Time
+		TimestampDerived time.Time
+	}
+
+	var rows []row
+	err := EventLogRows(context.Background(), client, "0AT000000000001", func(r row) error {
+		rows = append(rows, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 1 {
+		t.Fatalf("rows = %d, want 1", len(rows))
+	}
+
+	want := time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC)
+
+	if !rows[0].Timestamp.Equal(want) {
+		t.Errorf("Timestamp = %v, want %v", rows[0].Timestamp, want)
+	}
+
+	if !rows[0].TimestampDerived.Equal(want) {
+		t.Errorf("TimestampDerived = %v, want %v", rows[0].TimestampDerived, want)
+	}
+
+}
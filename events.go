@@ -0,0 +1,47 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+)
+
+/*
+ *	PublishEvent
+ *	Publishes a platform event, e.g. Order_Placed__e, with the given field values, and returns the ID reported for it.
+ *	Whether the event is published immediately or after the transaction depends on the event definition.
+ *	@since	2.0.0
+ */
+func (c *Client) PublishEvent(ctx context.Context, eventApiName string, payload map[string]interface{}) (string, error) {
+
+	return c.Create(ctx, eventApiName, payload)
+
+}
+
+/*
+ *	PublishEvents
+ *	Publishes up to 200 platform events of the same type in a single call.
+ *	The results are returned in the order of the events.
+ *	@since	2.0.0
+ */
+func (c *Client) PublishEvents(ctx context.Context, eventApiName string, payloads []map[string]interface{}) ([]SaveResult, error) {
+
+	return c.CollectionsCreate(ctx, eventApiName, false, payloads)
+
+}
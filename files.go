@@ -0,0 +1,364 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	DownloadBlob
+ *	Streams the content of a blob field of a record, e.g. Attachment.Body, Document.Body, or ContentVersion.VersionData,
+ *	without loading it into memory. The caller must close the returned reader.
+ *	@since	2.0.0
+ */
+func (c *Client) DownloadBlob(ctx context.Context, object string, id string, field string) (io.ReadCloser, error) {
+
+	request, err := c.newRequest(ctx, http.MethodGet, c.dataUrl(ctx, "sobjects/"+object+"/"+id+"/"+field), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "*/*")
+
+	response, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Body, nil
+
+}
+
+/*
+ *	UploadFile
+ *	Uploads a file as a new ContentVersion, creating its ContentDocument, and returns the ID of the ContentVersion.
+ *	The content is streamed as multipart/form-data rather than base64-encoded JSON, so files of up to 2 GB are supported;
+ *	it cannot be sent again, so the upload is not retried.
+ *	@since	2.0.0
+ */
+func (c *Client) UploadFile(ctx context.Context, title string, filename string, r io.Reader) (string, error) {
+
+	fields := map[string]interface{}{
+		"Title":        title,
+		"PathOnClient": filename,
+	}
+
+	return c.createWithBlob(ctx, "ContentVersion", "entity_content", fields, "VersionData", filename, r)
+
+}
+
+/*
+ *	CreateAttachment
+ *	Creates a classic Attachment of the record with the given ID, streaming its body as multipart/form-data,
+ *	and returns the ID of the Attachment. The upload is not retried.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateAttachment(ctx context.Context, parentId string, name string, contentType string, r io.Reader) (string, error) {
+
+	fields := map[string]interface{}{
+		"ParentId": parentId,
+		"Name":     name,
+	}
+
+	if contentType != "" {
+		fields["ContentType"] = contentType
+	}
+
+	return c.createWithBlob(ctx, "Attachment", "entity_attachment", fields, "Body", name, r)
+
+}
+
+/*
+ *	CreateDocument
+ *	Creates a Document in the folder with the given ID, streaming its body as multipart/form-data,
+ *	and returns the ID of the Document. fields may set other fields, e.g. Description or IsPublic.
+ *	The upload is not retried.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateDocument(ctx context.Context, folderId string, name string, fields map[string]interface{}, r io.Reader) (string, error) {
+
+	entity := make(map[string]interface{}, len(fields)+2)
+	for key, value := range fields {
+		entity[key] = value
+	}
+
+	entity["FolderId"] = folderId
+	entity["Name"] = name
+
+	return c.createWithBlob(ctx, "Document", "entity_document", entity, "Body", name, r)
+
+}
+
+/*
+ *	ShareType
+ *	Permission granted on a file to the users who can access a linked record.
+ *	@since	2.0.0
+ */
+type ShareType string
+
+/*
+ *	Share types.
+ *	@since	2.0.0
+ */
+const (
+	// Users can view and download the file.
+	ShareViewer ShareType = "V"
+
+	// Users can also edit the file.
+	ShareCollaborator ShareType = "C"
+
+	// Permission is inferred from the access of users to the linked record.
+	ShareInferred ShareType = "I"
+)
+
+/*
+ *	FileVisibility
+ *	Users who can see a file linked to a record.
+ *	@since	2.0.0
+ */
+type FileVisibility string
+
+/*
+ *	File visibilities.
+ *	@since	2.0.0
+ */
+const (
+	// All users who have access to the record, including community users.
+	VisibilityAllUsers FileVisibility = "AllUsers"
+
+	// Only internal users who have access to the record.
+	VisibilityInternalUsers FileVisibility = "InternalUsers"
+
+	// Users in the network of the record, in Experience Cloud sites.
+	VisibilitySharedUsers FileVisibility = "SharedUsers"
+)
+
+/*
+ *	FileLink
+ *	A file linked to a record, through a ContentDocumentLink.
+ *	@since	2.0.0
+ */
+type FileLink struct {
+	// ID of the ContentDocumentLink.
+	Id string `sf:"Id"`
+
+	ContentDocumentId string         `sf:"ContentDocumentId"`
+	LinkedEntityId    string         `sf:"LinkedEntityId"`
+	ShareType         ShareType      `sf:"ShareType"`
+	Visibility        FileVisibility `sf:"Visibility"`
+
+	Title                    string `sf:"ContentDocument.Title"`
+	FileExtension            string `sf:"ContentDocument.FileExtension"`
+	FileType                 string `sf:"ContentDocument.FileType"`
+	ContentSize              int64  `sf:"ContentDocument.ContentSize"`
+	LatestPublishedVersionId string `sf:"ContentDocument.LatestPublishedVersionId"`
+	LastModifiedDate         string `sf:"ContentDocument.LastModifiedDate"`
+}
+
+/*
+ *	ContentDocumentId
+ *	Returns the ID of the ContentDocument of a ContentVersion, e.g. one returned by UploadFile, to link it to records.
+ *	@since	2.0.0
+ */
+func (c *Client) ContentDocumentId(ctx context.Context, contentVersionId string) (string, error) {
+
+	var version struct {
+		ContentDocumentId string
+	}
+
+	if err := c.GetRecordInto(ctx, "ContentVersion", contentVersionId, &version, "ContentDocumentId"); err != nil {
+		return "", err
+	}
+
+	return version.ContentDocumentId, nil
+
+}
+
+/*
+ *	LinkFile
+ *	Shares a file with the users who can access a record, a user, a group, or a library,
+ *	by creating a ContentDocumentLink, and returns its ID.
+ *	@since	2.0.0
+ */
+func (c *Client) LinkFile(ctx context.Context, contentDocumentId string, linkedEntityId string, shareType ShareType, visibility FileVisibility) (string, error) {
+
+	link := map[string]interface{}{
+		"ContentDocumentId": contentDocumentId,
+		"LinkedEntityId":    linkedEntityId,
+		"ShareType":         shareType,
+	}
+
+	if visibility != "" {
+		link["Visibility"] = visibility
+	}
+
+	return c.Create(ctx, "ContentDocumentLink", link)
+
+}
+
+/*
+ *	UpdateFileLink
+ *	Changes the share type and visibility of a ContentDocumentLink.
+ *	@since	2.0.0
+ */
+func (c *Client) UpdateFileLink(ctx context.Context, linkId string, shareType ShareType, visibility FileVisibility) error {
+
+	link := map[string]interface{}{
+		"ShareType": shareType,
+	}
+
+	if visibility != "" {
+		link["Visibility"] = visibility
+	}
+
+	return c.Update(ctx, "ContentDocumentLink", linkId, link)
+
+}
+
+/*
+ *	UnlinkFile
+ *	Stops sharing a file by deleting a ContentDocumentLink. The file itself is not deleted.
+ *	@since	2.0.0
+ */
+func (c *Client) UnlinkFile(ctx context.Context, linkId string) error {
+
+	return c.Delete(ctx, "ContentDocumentLink", linkId)
+
+}
+
+/*
+ *	ListFiles
+ *	Returns the files linked to a record, a user, a group, or a library, most recently modified first.
+ *	@since	2.0.0
+ */
+func (c *Client) ListFiles(ctx context.Context, linkedEntityId string) ([]FileLink, error) {
+
+	statement, err := soql.Format(`SELECT Id, ContentDocumentId, LinkedEntityId, ShareType, Visibility,
+		ContentDocument.Title, ContentDocument.FileExtension, ContentDocument.FileType, ContentDocument.ContentSize,
+		ContentDocument.LatestPublishedVersionId, ContentDocument.LastModifiedDate
+		FROM ContentDocumentLink WHERE LinkedEntityId = ? ORDER BY ContentDocument.LastModifiedDate DESC`, linkedEntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	return QueryInto[FileLink](ctx, c, statement)
+
+}
+
+/*
+ *	createWithBlob
+ *	Creates a record with the given fields and the content of a blob field, streamed as multipart/form-data,
+ *	and returns its ID. entityPart names the part holding the fields, e.g. entity_content for ContentVersion.
+ *	@since	2.0.0
+ */
+func (c *Client) createWithBlob(ctx context.Context, object string, entityPart string, fields map[string]interface{}, blobField string, filename string, r io.Reader) (string, error) {
+
+	entity, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	form := multipart.NewWriter(writer)
+
+	go func() {
+		writer.CloseWithError(writeBlobForm(form, entityPart, entity, blobField, filename, r))
+	}()
+
+	request, err := c.newRequest(ctx, http.MethodPost, c.dataUrl(ctx, "sobjects/"+object+"/"), reader)
+	if err != nil {
+		return "", err
+	}
+
+	request.Header.Set("Content-Type", form.FormDataContentType())
+
+	response, err := c.do(request)
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	var result SaveResult
+
+	// 201 Created
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	if err := result.Err(); err != nil {
+		return "", err
+	}
+
+	return result.Id, nil
+
+}
+
+/*
+ *	writeBlobForm
+ *	Writes the fields of a record as JSON and the content of its blob field as the parts of a form.
+ *	@since	2.0.0
+ */
+func writeBlobForm(form *multipart.Writer, entityPart string, entity []byte, blobField string, filename string, r io.Reader) error {
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="`+entityPart+`"`)
+	header.Set("Content-Type", "application/json")
+
+	part, err := form.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := part.Write(entity); err != nil {
+		return err
+	}
+
+	header = textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="`+blobField+`"; filename="`+quoteEscaper.Replace(filename)+`"`)
+	header.Set("Content-Type", "application/octet-stream")
+
+	if part, err = form.CreatePart(header); err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(part, r); err != nil {
+		return err
+	}
+
+	return form.Close()
+
+}
+
+/*
+ *	Escapes the quotes and backslashes of file names in Content-Disposition headers, as mime/multipart does.
+ *	@since	2.0.0
+ */
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
@@ -0,0 +1,199 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	GraphQLError
+ *	An error reported by the GraphQL API, e.g. a syntax error or a field the user cannot access.
+ *	@since	2.0.0
+ */
+type GraphQLError struct {
+	Message   string `json:"message"`
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations"`
+
+	// Path of the field that failed, e.g. ["uiapi", "query", "Account"].
+	Path []interface{} `json:"path"`
+
+	// Details of the error, e.g. its ErrorType, such as ValidationError or InvalidSyntax.
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *GraphQLError) Error() string {
+
+	if errorType, ok := e.Extensions["ErrorType"].(string); ok {
+		return "salesforce: graphql " + errorType + ": " + e.Message
+	}
+
+	return "salesforce: graphql: " + e.Message
+
+}
+
+/*
+ *	GraphQLErrors
+ *	The errors of a GraphQL response. Data may still have been returned for the fields that did not fail.
+ *	@since	2.0.0
+ */
+type GraphQLErrors []*GraphQLError
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e GraphQLErrors) Error() string {
+
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+
+}
+
+/*
+ *	Unwrap
+ *	@since	2.0.0
+ */
+func (e GraphQLErrors) Unwrap() []error {
+
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+
+}
+
+/*
+ *	GraphQL
+ *	Executes a GraphQL query or mutation with the given variables, which may be nil,
+ *	and decodes its data into out, if not nil. Queries of records use the uiapi root, e.g.
+ *	query { uiapi { query { Account(first: 10) { edges { node { Id Name { value } } } } } } },
+ *	and enforce field-level security. Returns GraphQLErrors, after decoding any data, if the query reported errors.
+ *	@since	2.0.0
+ */
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+
+	request := map[string]interface{}{"query": query}
+	if variables != nil {
+		request["variables"] = variables
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "graphql"), request, &response); err != nil {
+		return err
+	}
+
+	if out != nil && len(response.Data) > 0 && string(response.Data) != "null" {
+		if err := json.Unmarshal(response.Data, out); err != nil {
+			return err
+		}
+	}
+
+	if len(response.Errors) > 0 {
+		return response.Errors
+	}
+
+	return nil
+
+}
+
+/*
+ *	GraphQLConnection
+ *	A page of a GraphQL connection, as returned for queries of records.
+ *	@since	2.0.0
+ */
+type GraphQLConnection struct {
+	Edges []struct {
+		Node   json.RawMessage `json:"node"`
+		Cursor string          `json:"cursor"`
+	} `json:"edges"`
+
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+
+	TotalCount int `json:"totalCount"`
+}
+
+/*
+ *	GraphQLEach
+ *	Executes a GraphQL query repeatedly to retrieve all pages of the connection at the given dotted path,
+ *	e.g. uiapi.query.Account, and calls fn with each node. The query must declare an $after: String variable,
+ *	pass it to the connection, and select pageInfo { hasNextPage endCursor }.
+ *	@since	2.0.0
+ */
+func (c *Client) GraphQLEach(ctx context.Context, query string, variables map[string]interface{}, path string, fn func(node json.RawMessage) error) error {
+
+	pageVariables := make(map[string]interface{}, len(variables)+1)
+	for name, value := range variables {
+		pageVariables[name] = value
+	}
+
+	for {
+		var data map[string]json.RawMessage
+		if err := c.GraphQL(ctx, query, pageVariables, &data); err != nil {
+			return err
+		}
+
+		raw, ok := lookupField(data, path)
+		if !ok {
+			return errors.New("salesforce: graphql response has no connection at " + path)
+		}
+
+		var connection GraphQLConnection
+		if err := json.Unmarshal(raw, &connection); err != nil {
+			return err
+		}
+
+		for _, edge := range connection.Edges {
+			if err := fn(edge.Node); err != nil {
+				return err
+			}
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return nil
+		}
+
+		pageVariables["after"] = connection.PageInfo.EndCursor
+	}
+
+}
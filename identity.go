@@ -0,0 +1,200 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	Photos
+ *	URLs of a user's profile photos.
+ *	@since	2.0.0
+ */
+type Photos struct {
+	Picture   string `json:"picture"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+/*
+ *	UserInfo
+ *	The OpenID Connect claims of the authenticated user.
+ *	@since	2.0.0
+ */
+type UserInfo struct {
+	Sub               string            `json:"sub"`
+	UserId            string            `json:"user_id"`
+	OrganizationId    string            `json:"organization_id"`
+	PreferredUsername string            `json:"preferred_username"`
+	Nickname          string            `json:"nickname"`
+	Name              string            `json:"name"`
+	GivenName         string            `json:"given_name"`
+	FamilyName        string            `json:"family_name"`
+	Email             string            `json:"email"`
+	EmailVerified     bool              `json:"email_verified"`
+	Zoneinfo          string            `json:"zoneinfo"`
+	Locale            string            `json:"locale"`
+	Language          string            `json:"language"`
+	UtcOffset         int               `json:"utcOffset"`
+	UserType          string            `json:"user_type"`
+	Active            bool              `json:"active"`
+	Profile           string            `json:"profile"`
+	Picture           string            `json:"picture"`
+	Photos            Photos            `json:"photos"`
+	Urls              map[string]string `json:"urls"`
+	UpdatedAt         string            `json:"updated_at"`
+}
+
+/*
+ *	Identity
+ *	The authenticated user, as described by the identity URL returned with the access token.
+ *	@since	2.0.0
+ */
+type Identity struct {
+	Id               string            `json:"id"`
+	AssertedUser     bool              `json:"asserted_user"`
+	UserId           string            `json:"user_id"`
+	OrganizationId   string            `json:"organization_id"`
+	Username         string            `json:"username"`
+	NickName         string            `json:"nick_name"`
+	DisplayName      string            `json:"display_name"`
+	Email            string            `json:"email"`
+	EmailVerified    bool              `json:"email_verified"`
+	FirstName        string            `json:"first_name"`
+	LastName         string            `json:"last_name"`
+	Timezone         string            `json:"timezone"`
+	Photos           Photos            `json:"photos"`
+	MobilePhone      string            `json:"mobile_phone"`
+	Status           json.RawMessage   `json:"status"`
+	Urls             map[string]string `json:"urls"`
+	Active           bool              `json:"active"`
+	UserType         string            `json:"user_type"`
+	Language         string            `json:"language"`
+	Locale           string            `json:"locale"`
+	UtcOffset        int               `json:"utcOffset"`
+	LastModifiedDate string            `json:"last_modified_date"`
+}
+
+/*
+ *	Url
+ *	Returns an API endpoint of the org by name, e.g. rest, sobjects, query, metadata, or partner,
+ *	with its version placeholder replaced by the given API version, e.g. "61.0", or "" if there is no such endpoint.
+ *	@since	2.0.0
+ */
+func (i *Identity) Url(name string, version string) string {
+
+	return strings.ReplaceAll(i.Urls[name], "{version}", strings.TrimPrefix(version, "v"))
+
+}
+
+/*
+ *	WithIdentity
+ *	Resolves the identity URL whenever the client obtains an access token for a new user,
+ *	so the identity is available from Identity without a further call.
+ *	Resolution is best-effort: if it fails, the access token is kept and the call obtaining it succeeds,
+ *	Identity returns nil, and resolution is attempted again with the next token.
+ *	The callback, if not nil, is called with the outcome of every resolution.
+ *	@since	2.0.0
+ */
+func WithIdentity(callback func(identity *Identity, err error)) Option {
+
+	return func(c *Client) {
+		c.resolveIdentity = true
+		c.identityCallback = callback
+	}
+
+}
+
+/*
+ *	GetUserInfo
+ *	Returns the OpenID Connect claims of the authenticated user.
+ *	@since	2.0.0
+ */
+func (c *Client) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+
+	var userInfo UserInfo
+
+	if err := c.doJSON(ctx, http.MethodGet, c.baseUrl()+"/services/oauth2/userinfo", nil, &userInfo); err != nil {
+		return nil, err
+	}
+
+	return &userInfo, nil
+
+}
+
+/*
+ *	IdentityUrl
+ *	Returns the identity URL returned with the access token, or "" if the client was given its token.
+ *	@since	2.0.0
+ */
+func (c *Client) IdentityUrl() string {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.identityUrl
+
+}
+
+/*
+ *	GetIdentity
+ *	Returns the authenticated user, as described by the identity URL returned with the access token.
+ *	@since	2.0.0
+ */
+func (c *Client) GetIdentity(ctx context.Context) (*Identity, error) {
+
+	identityUrl := c.IdentityUrl()
+	if identityUrl == "" {
+		return nil, errors.New("salesforce: no identity URL; obtain the access token with one of the OAuth 2.0 flows")
+	}
+
+	var identity Identity
+
+	if err := c.doJSON(ctx, http.MethodGet, identityUrl, nil, &identity); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if c.identityUrl == identityUrl {
+		c.identity = &identity
+	}
+	c.mu.Unlock()
+
+	return &identity, nil
+
+}
+
+/*
+ *	Identity
+ *	Returns the identity of the authenticated user last returned by GetIdentity, or resolved with WithIdentity,
+ *	or nil if it has not been resolved since the access token was obtained.
+ *	@since	2.0.0
+ */
+func (c *Client) Identity() *Identity {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.identity
+
+}
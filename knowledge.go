@@ -0,0 +1,267 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+/*
+ *	Channels through which Knowledge articles are published.
+ *	@since	2.0.0
+ */
+const (
+	// Internal app.
+	ChannelApp string = "App"
+
+	// Public knowledge base.
+	ChannelPkb string = "Pkb"
+
+	// Customer portal or Experience Cloud site.
+	ChannelCsp string = "Csp"
+
+	// Partner portal.
+	ChannelPrm string = "Prm"
+)
+
+/*
+ *	KnowledgeQuery
+ *	Filters and sorts the published Knowledge articles listed by KnowledgeArticles.
+ *	@since	2.0.0
+ */
+type KnowledgeQuery struct {
+	// Search terms; all articles are listed if empty.
+	Q string
+
+	// Language of the articles, e.g. en-US; defaults to the locale of the client, which the API requires.
+	Language string
+
+	// Channel of the articles, e.g. ChannelPkb; defaults to that of the user.
+	Channel string
+
+	// Data categories by data category group, e.g. {"Products": "Laptops"}.
+	Categories map[string]string
+
+	// How articles are matched to Categories: AT, BELOW, ABOVE, or ABOVE_OR_BELOW; defaults to BELOW.
+	QueryMethod string
+
+	// LastPublishedDate, CreatedDate, Title, or ViewScore, and ASC or DESC.
+	Sort  string
+	Order string
+
+	// Up to 100 articles per page, from page 1.
+	PageSize   int
+	PageNumber int
+}
+
+/*
+ *	KnowledgeArticleSummary
+ *	A published Knowledge article, as listed by KnowledgeArticles.
+ *	@since	2.0.0
+ */
+type KnowledgeArticleSummary struct {
+	Id                string  `json:"id"`
+	ArticleNumber     string  `json:"articleNumber"`
+	Title             string  `json:"title"`
+	Summary           string  `json:"summary"`
+	UrlName           string  `json:"urlName"`
+	Url               string  `json:"url"`
+	LastPublishedDate string  `json:"lastPublishedDate"`
+	ViewCount         int     `json:"viewCount"`
+	ViewScore         float64 `json:"viewScore"`
+	UpVoteCount       int     `json:"upVoteCount"`
+	DownVoteCount     int     `json:"downVoteCount"`
+
+	CategoryGroups json.RawMessage `json:"categoryGroups"`
+}
+
+/*
+ *	KnowledgeArticlePage
+ *	A page of the articles listed by KnowledgeArticles.
+ *	@since	2.0.0
+ */
+type KnowledgeArticlePage struct {
+	Articles    []KnowledgeArticleSummary `json:"articles"`
+	PageNumber  int                       `json:"pageNumber"`
+	NextPageUrl string                    `json:"nextPageUrl"`
+}
+
+/*
+ *	KnowledgeArticle
+ *	A published Knowledge article with its fields.
+ *	@since	2.0.0
+ */
+type KnowledgeArticle struct {
+	Id                string  `json:"id"`
+	ArticleNumber     string  `json:"articleNumber"`
+	Title             string  `json:"title"`
+	Summary           string  `json:"summary"`
+	UrlName           string  `json:"urlName"`
+	Url               string  `json:"url"`
+	Version           int     `json:"versionNumber"`
+	LastPublishedDate string  `json:"lastPublishedDate"`
+	ViewCount         int     `json:"allViewCount"`
+	ViewScore         float64 `json:"allViewScore"`
+
+	// Fields of the article type, e.g. its body, in layout order.
+	LayoutItems []struct {
+		Name  string `json:"name"`
+		Label string `json:"label"`
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"layoutItems"`
+
+	CategoryGroups json.RawMessage `json:"categoryGroups"`
+}
+
+/*
+ *	DataCategoryGroup
+ *	A group of data categories classifying Knowledge articles.
+ *	@since	2.0.0
+ */
+type DataCategoryGroup struct {
+	Name          string         `json:"name"`
+	Label         string         `json:"label"`
+	ObjectUsage   string         `json:"objectUsage"`
+	TopCategories []DataCategory `json:"topCategories"`
+}
+
+/*
+ *	DataCategory
+ *	@since	2.0.0
+ */
+type DataCategory struct {
+	Name            string         `json:"name"`
+	Label           string         `json:"label"`
+	Url             string         `json:"url"`
+	ChildCategories []DataCategory `json:"childCategories"`
+}
+
+/*
+ *	KnowledgeArticles
+ *	Returns a page of the published Knowledge articles visible to the user, matching the query.
+ *	@since	2.0.0
+ */
+func (c *Client) KnowledgeArticles(ctx context.Context, query KnowledgeQuery) (*KnowledgeArticlePage, error) {
+
+	params := url.Values{}
+
+	if query.Q != "" {
+		params.Set("q", query.Q)
+	}
+
+	if query.Channel != "" {
+		params.Set("channel", query.Channel)
+	}
+
+	if len(query.Categories) > 0 {
+		categories, err := json.Marshal(query.Categories)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("categories", string(categories))
+	}
+
+	if query.QueryMethod != "" {
+		params.Set("queryMethod", query.QueryMethod)
+	}
+
+	if query.Sort != "" {
+		params.Set("sort", query.Sort)
+	}
+
+	if query.Order != "" {
+		params.Set("order", query.Order)
+	}
+
+	if query.PageSize > 0 {
+		params.Set("pageSize", strconv.Itoa(query.PageSize))
+	}
+
+	if query.PageNumber > 0 {
+		params.Set("pageNumber", strconv.Itoa(query.PageNumber))
+	}
+
+	if query.Language != "" {
+		ctx = ContextWithLocale(ctx, query.Language)
+	}
+
+	var page KnowledgeArticlePage
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "support/knowledgeArticles?"+params.Encode()), nil, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+
+}
+
+/*
+ *	GetKnowledgeArticle
+ *	Returns a published Knowledge article by its ID or URL name, in the given language, e.g. en-US, if not empty,
+ *	and channel, or that of the user if empty. Reading an article counts as a view.
+ *	@since	2.0.0
+ */
+func (c *Client) GetKnowledgeArticle(ctx context.Context, idOrUrlName string, language string, channel string) (*KnowledgeArticle, error) {
+
+	path := "support/knowledgeArticles/" + url.PathEscape(idOrUrlName)
+	if channel != "" {
+		path += "?channel=" + url.QueryEscape(channel)
+	}
+
+	if language != "" {
+		ctx = ContextWithLocale(ctx, language)
+	}
+
+	var article KnowledgeArticle
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, path), nil, &article); err != nil {
+		return nil, err
+	}
+
+	return &article, nil
+
+}
+
+/*
+ *	DataCategoryGroups
+ *	Returns the data category groups classifying Knowledge articles, with their categories, in the given language.
+ *	@since	2.0.0
+ */
+func (c *Client) DataCategoryGroups(ctx context.Context, language string) ([]DataCategoryGroup, error) {
+
+	if language != "" {
+		ctx = ContextWithLocale(ctx, language)
+	}
+
+	var list struct {
+		CategoryGroups []DataCategoryGroup `json:"categoryGroups"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "support/dataCategoryGroups?sObjectName=KnowledgeArticleVersion&topCategoriesOnly=false"), nil, &list); err != nil {
+		return nil, err
+	}
+
+	return list.CategoryGroups, nil
+
+}
@@ -0,0 +1,305 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+)
+
+/*
+ *	DescribeLayouts
+ *	The page layouts of an object and the record types they are assigned to.
+ *	@since	2.0.0
+ */
+type DescribeLayouts struct {
+	Layouts                    []Layout            `json:"layouts"`
+	RecordTypeMappings         []RecordTypeMapping `json:"recordTypeMappings"`
+	RecordTypeSelectorRequired []bool              `json:"recordTypeSelectorRequired"`
+}
+
+/*
+ *	Layout
+ *	A page layout: its sections, related lists, and quick actions.
+ *	@since	2.0.0
+ */
+type Layout struct {
+	Id                           string          `json:"id"`
+	DetailLayoutSections         []LayoutSection `json:"detailLayoutSections"`
+	EditLayoutSections           []LayoutSection `json:"editLayoutSections"`
+	HighlightsPanelLayoutSection *LayoutSection  `json:"highlightsPanelLayoutSection"`
+	MultirowEditLayoutSections   []LayoutSection `json:"multirowEditLayoutSections"`
+	ButtonLayoutSection          *ButtonSection  `json:"buttonLayoutSection"`
+	RelatedLists                 []RelatedList   `json:"relatedLists"`
+	QuickActionList              QuickActionList `json:"quickActionList"`
+}
+
+/*
+ *	LayoutSection
+ *	A section of a page layout, made of rows of items.
+ *	@since	2.0.0
+ */
+type LayoutSection struct {
+	Heading               string      `json:"heading"`
+	UseHeading            bool        `json:"useHeading"`
+	UseCollapsibleSection bool        `json:"useCollapsibleSection"`
+	Collapsed             bool        `json:"collapsed"`
+	Columns               int         `json:"columns"`
+	Rows                  int         `json:"rows"`
+	TabOrder              string      `json:"tabOrder"`
+	LayoutSectionId       string      `json:"layoutSectionId"`
+	LayoutRows            []LayoutRow `json:"layoutRows"`
+}
+
+/*
+ *	LayoutRow
+ *	A row of a layout section.
+ *	@since	2.0.0
+ */
+type LayoutRow struct {
+	NumItems    int          `json:"numItems"`
+	LayoutItems []LayoutItem `json:"layoutItems"`
+}
+
+/*
+ *	LayoutItem
+ *	A cell of a layout row, usually showing a single field.
+ *	@since	2.0.0
+ */
+type LayoutItem struct {
+	Label             string            `json:"label"`
+	Placeholder       bool              `json:"placeholder"`
+	Required          bool              `json:"required"`
+	EditableForNew    bool              `json:"editableForNew"`
+	EditableForUpdate bool              `json:"editableForUpdate"`
+	LayoutComponents  []LayoutComponent `json:"layoutComponents"`
+}
+
+/*
+ *	LayoutComponent
+ *	A component of a layout item, e.g. a Field, whose name is the value.
+ *	@since	2.0.0
+ */
+type LayoutComponent struct {
+	Type         string `json:"type"`
+	Value        string `json:"value"`
+	DisplayLines int    `json:"displayLines"`
+	TabOrder     int    `json:"tabOrder"`
+
+	// The describe of the field, for components of type Field.
+	Details json.RawMessage `json:"details"`
+}
+
+/*
+ *	ButtonSection
+ *	The buttons of a page layout.
+ *	@since	2.0.0
+ */
+type ButtonSection struct {
+	DetailButtons []Button `json:"detailButtons"`
+}
+
+/*
+ *	Button
+ *	A button of a page layout or related list.
+ *	@since	2.0.0
+ */
+type Button struct {
+	Name   string `json:"name"`
+	Label  string `json:"label"`
+	Custom bool   `json:"custom"`
+	Url    string `json:"url"`
+}
+
+/*
+ *	RelatedList
+ *	A related list of a page layout.
+ *	@since	2.0.0
+ */
+type RelatedList struct {
+	Name      string              `json:"name"`
+	Label     string              `json:"label"`
+	SObject   string              `json:"sobject"`
+	Field     string              `json:"field"`
+	Custom    bool                `json:"custom"`
+	LimitRows int                 `json:"limitRows"`
+	Columns   []RelatedListColumn `json:"columns"`
+	Sort      []RelatedListSort   `json:"sort"`
+	Buttons   []Button            `json:"buttons"`
+}
+
+/*
+ *	RelatedListColumn
+ *	A column of a related list.
+ *	@since	2.0.0
+ */
+type RelatedListColumn struct {
+	Name   string `json:"name"`
+	Label  string `json:"label"`
+	Field  string `json:"field"`
+	Format string `json:"format"`
+}
+
+/*
+ *	RelatedListSort
+ *	A sort order of a related list.
+ *	@since	2.0.0
+ */
+type RelatedListSort struct {
+	Column    string `json:"column"`
+	Ascending bool   `json:"ascending"`
+}
+
+/*
+ *	QuickActionList
+ *	The quick actions of a page layout.
+ *	@since	2.0.0
+ */
+type QuickActionList struct {
+	QuickActionListItems []QuickActionListItem `json:"quickActionListItems"`
+}
+
+/*
+ *	QuickActionListItem
+ *	A quick action of a page layout.
+ *	@since	2.0.0
+ */
+type QuickActionListItem struct {
+	Label               string `json:"label"`
+	QuickActionName     string `json:"quickActionName"`
+	Type                string `json:"type"`
+	TargetSObjectType   string `json:"targetSobjectType"`
+	AccessLevelRequired string `json:"accessLevelRequired"`
+}
+
+/*
+ *	RecordTypeMapping
+ *	The page layout assigned to a record type, and the picklist values available for it.
+ *	@since	2.0.0
+ */
+type RecordTypeMapping struct {
+	Name                     string                  `json:"name"`
+	DeveloperName            string                  `json:"developerName"`
+	RecordTypeId             string                  `json:"recordTypeId"`
+	LayoutId                 string                  `json:"layoutId"`
+	Active                   bool                    `json:"active"`
+	Available                bool                    `json:"available"`
+	DefaultRecordTypeMapping bool                    `json:"defaultRecordTypeMapping"`
+	Master                   bool                    `json:"master"`
+	PicklistsForRecordType   []PicklistForRecordType `json:"picklistsForRecordType"`
+}
+
+/*
+ *	PicklistForRecordType
+ *	The values of a picklist field available for a record type.
+ *	@since	2.0.0
+ */
+type PicklistForRecordType struct {
+	PicklistName   string          `json:"picklistName"`
+	PicklistValues []PicklistValue `json:"picklistValues"`
+}
+
+/*
+ *	CompactLayouts
+ *	The compact layouts of an object and the record types they are assigned to.
+ *	@since	2.0.0
+ */
+type CompactLayouts struct {
+	CompactLayouts                  []CompactLayout                  `json:"compactLayouts"`
+	DefaultCompactLayoutId          string                           `json:"defaultCompactLayoutId"`
+	RecordTypeCompactLayoutMappings []RecordTypeCompactLayoutMapping `json:"recordTypeCompactLayoutMappings"`
+}
+
+/*
+ *	CompactLayout
+ *	A compact layout: the key fields shown in the highlights of a record.
+ *	@since	2.0.0
+ */
+type CompactLayout struct {
+	Id         string       `json:"id"`
+	Name       string       `json:"name"`
+	Label      string       `json:"label"`
+	FieldItems []LayoutItem `json:"fieldItems"`
+	ImageItems []LayoutItem `json:"imageItems"`
+	Actions    []Button     `json:"actions"`
+}
+
+/*
+ *	RecordTypeCompactLayoutMapping
+ *	The compact layout assigned to a record type.
+ *	@since	2.0.0
+ */
+type RecordTypeCompactLayoutMapping struct {
+	RecordTypeId      string `json:"recordTypeId"`
+	RecordTypeName    string `json:"recordTypeName"`
+	CompactLayoutId   string `json:"compactLayoutId"`
+	CompactLayoutName string `json:"compactLayoutName"`
+	Available         bool   `json:"available"`
+}
+
+/*
+ *	DescribeLayouts
+ *	Returns the page layouts of an object, for every record type available to the user.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeLayouts(ctx context.Context, object string) (*DescribeLayouts, error) {
+
+	var layouts DescribeLayouts
+
+	if err := c.describe(ctx, c.dataUrl(ctx, "sobjects/"+object+"/describe/layouts/"), &layouts); err != nil {
+		return nil, err
+	}
+
+	return &layouts, nil
+
+}
+
+/*
+ *	DescribeLayout
+ *	Returns the page layout of an object assigned to a record type.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeLayout(ctx context.Context, object string, recordTypeId string) (*Layout, error) {
+
+	var layout Layout
+
+	if err := c.describe(ctx, c.dataUrl(ctx, "sobjects/"+object+"/describe/layouts/"+recordTypeId), &layout); err != nil {
+		return nil, err
+	}
+
+	return &layout, nil
+
+}
+
+/*
+ *	DescribeCompactLayouts
+ *	Returns the compact layouts of an object.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeCompactLayouts(ctx context.Context, object string) (*CompactLayouts, error) {
+
+	var layouts CompactLayouts
+
+	if err := c.describe(ctx, c.dataUrl(ctx, "sobjects/"+object+"/describe/compactLayouts/"), &layouts); err != nil {
+		return nil, err
+	}
+
+	return &layouts, nil
+
+}
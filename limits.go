@@ -0,0 +1,205 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*
+ *	ApiUsage
+ *	The number of API requests made by the org in the last 24 hours, and its daily limit,
+ *	as reported by the Sforce-Limit-Info header.
+ *	@since	2.0.0
+ */
+type ApiUsage struct {
+	Used  int
+	Limit int
+}
+
+/*
+ *	Remaining
+ *	Returns the number of API requests the org can still make in the current 24 hours.
+ *	@since	2.0.0
+ */
+func (u ApiUsage) Remaining() int {
+
+	return u.Limit - u.Used
+
+}
+
+/*
+ *	WithApiUsageCallback
+ *	Sets a function called with the API usage reported by every response, e.g. to alert
+ *	before the org reaches its daily limit. The function must be safe for concurrent use.
+ *	@since	2.0.0
+ */
+func WithApiUsageCallback(callback func(ApiUsage)) Option {
+
+	return func(c *Client) {
+		c.apiUsageCallback = callback
+	}
+
+}
+
+/*
+ *	LastApiUsage
+ *	Returns the API usage reported by the most recent response, or a zero ApiUsage if none was reported.
+ *	@since	2.0.0
+ */
+func (c *Client) LastApiUsage() ApiUsage {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.apiUsage
+
+}
+
+/*
+ *	recordApiUsage
+ *	Records the API usage reported by a response, if any.
+ *	@since	2.0.0
+ */
+func (c *Client) recordApiUsage(response *http.Response) {
+
+	usage, ok := parseApiUsage(response.Header.Get("Sforce-Limit-Info"))
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	c.apiUsage = usage
+	c.mu.Unlock()
+
+	if c.apiUsageCallback != nil {
+		c.apiUsageCallback(usage)
+	}
+
+}
+
+/*
+ *	parseApiUsage
+ *	Parses a Sforce-Limit-Info header, e.g. "api-usage=25/15000".
+ *	@since	2.0.0
+ */
+func parseApiUsage(header string) (ApiUsage, bool) {
+
+	for _, part := range strings.Split(header, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || name != "api-usage" {
+			continue
+		}
+
+		used, limit, ok := strings.Cut(value, "/")
+		if !ok {
+			return ApiUsage{}, false
+		}
+
+		usedCount, err1 := strconv.Atoi(used)
+		limitCount, err2 := strconv.Atoi(limit)
+		if err1 != nil || err2 != nil {
+			return ApiUsage{}, false
+		}
+
+		return ApiUsage{Used: usedCount, Limit: limitCount}, true
+	}
+
+	return ApiUsage{}, false
+
+}
+
+/*
+ *	Limit
+ *	The maximum and remaining allocation of an org limit.
+ *	@since	2.0.0
+ */
+type Limit struct {
+	Max       int64 `json:"Max"`
+	Remaining int64 `json:"Remaining"`
+}
+
+/*
+ *	Used
+ *	Returns the consumed allocation of the limit.
+ *	@since	2.0.0
+ */
+func (l Limit) Used() int64 {
+
+	return l.Max - l.Remaining
+
+}
+
+/*
+ *	Limits
+ *	The limits of an org. Commonly monitored limits have their own fields;
+ *	All holds every limit reported by the org, keyed by name.
+ *	@since	2.0.0
+ */
+type Limits struct {
+	DailyApiRequests                  Limit `json:"DailyApiRequests"`
+	DailyAsyncApexExecutions          Limit `json:"DailyAsyncApexExecutions"`
+	DailyBulkApiBatches               Limit `json:"DailyBulkApiBatches"`
+	DailyBulkV2QueryJobs              Limit `json:"DailyBulkV2QueryJobs"`
+	DailyBulkV2QueryFileStorageMB     Limit `json:"DailyBulkV2QueryFileStorageMB"`
+	DailyDeliveredPlatformEvents      Limit `json:"DailyDeliveredPlatformEvents"`
+	DailyStreamingApiEvents           Limit `json:"DailyStreamingApiEvents"`
+	DailyWorkflowEmails               Limit `json:"DailyWorkflowEmails"`
+	DataStorageMB                     Limit `json:"DataStorageMB"`
+	FileStorageMB                     Limit `json:"FileStorageMB"`
+	HourlyPublishedPlatformEvents     Limit `json:"HourlyPublishedPlatformEvents"`
+	HourlyTimeBasedWorkflow           Limit `json:"HourlyTimeBasedWorkflow"`
+	ConcurrentAsyncGetReportInstances Limit `json:"ConcurrentAsyncGetReportInstances"`
+	ConcurrentSyncReportRuns          Limit `json:"ConcurrentSyncReportRuns"`
+	MassEmail                         Limit `json:"MassEmail"`
+	SingleEmail                       Limit `json:"SingleEmail"`
+
+	All map[string]Limit `json:"-"`
+}
+
+/*
+ *	GetLimits
+ *	Returns the limits of the org and their remaining allocations.
+ *	@since	2.0.0
+ */
+func (c *Client) GetLimits(ctx context.Context) (*Limits, error) {
+
+	var raw json.RawMessage
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "limits/"), nil, &raw); err != nil {
+		return nil, err
+	}
+
+	var limits Limits
+
+	if err := json.Unmarshal(raw, &limits); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, &limits.All); err != nil {
+		return nil, err
+	}
+
+	return &limits, nil
+
+}
@@ -0,0 +1,218 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+/*
+ *	ListView
+ *	A list view of an object, as maintained by admins and users in the Salesforce UI.
+ *	@since	2.0.0
+ */
+type ListView struct {
+	Id            string `json:"id"`
+	DeveloperName string `json:"developerName"`
+	Label         string `json:"label"`
+	DescribeUrl   string `json:"describeUrl"`
+	ResultsUrl    string `json:"resultsUrl"`
+
+	// Whether the list view can be expressed in SOQL, e.g. not for Recently Viewed.
+	SoqlCompatible bool `json:"soqlCompatible"`
+}
+
+/*
+ *	ListViewDescribe
+ *	The definition of a list view, with the SOQL query returning its records.
+ *	@since	2.0.0
+ */
+type ListViewDescribe struct {
+	Id             string                 `json:"id"`
+	SObjectType    string                 `json:"sobjectType"`
+	Query          string                 `json:"query"`
+	Scope          string                 `json:"scope"`
+	Columns        []ListViewColumn       `json:"columns"`
+	OrderBy        []ListViewOrderBy      `json:"orderBy"`
+	WhereCondition map[string]interface{} `json:"whereCondition"`
+}
+
+/*
+ *	ListViewColumn
+ *	@since	2.0.0
+ */
+type ListViewColumn struct {
+	FieldNameOrPath string `json:"fieldNameOrPath"`
+	Label           string `json:"label"`
+	Type            string `json:"type"`
+	Sortable        bool   `json:"sortable"`
+
+	// Hidden columns, e.g. Id, are returned but not displayed.
+	Hidden bool `json:"hidden"`
+}
+
+/*
+ *	ListViewOrderBy
+ *	@since	2.0.0
+ */
+type ListViewOrderBy struct {
+	FieldNameOrPath string `json:"fieldNameOrPath"`
+	SortDirection   string `json:"sortDirection"`
+	NullsPosition   string `json:"nullsPosition"`
+}
+
+/*
+ *	ListViewResult
+ *	A page of the records of a list view.
+ *	@since	2.0.0
+ */
+type ListViewResult struct {
+	Id            string           `json:"id"`
+	DeveloperName string           `json:"developerName"`
+	Label         string           `json:"label"`
+	Columns       []ListViewColumn `json:"columns"`
+	Records       []ListViewRecord `json:"records"`
+	Size          int              `json:"size"`
+	Done          bool             `json:"done"`
+}
+
+/*
+ *	ListViewRecord
+ *	A record of a list view, with a value for each column of the list view.
+ *	@since	2.0.0
+ */
+type ListViewRecord struct {
+	Columns []struct {
+		FieldNameOrPath string  `json:"fieldNameOrPath"`
+		Value           *string `json:"value"`
+	} `json:"columns"`
+}
+
+/*
+ *	Value
+ *	Returns the value of the column for the given field, e.g. Owner.Alias, or an empty string if it is null.
+ *	@since	2.0.0
+ */
+func (r *ListViewRecord) Value(field string) string {
+
+	for _, column := range r.Columns {
+		if column.FieldNameOrPath == field && column.Value != nil {
+			return *column.Value
+		}
+	}
+
+	return ""
+
+}
+
+/*
+ *	ListViews
+ *	Returns the list views of an object visible to the user.
+ *	@since	2.0.0
+ */
+func (c *Client) ListViews(ctx context.Context, object string) ([]ListView, error) {
+
+	var views []ListView
+
+	for url := c.dataUrl(ctx, "sobjects/"+object+"/listviews"); url != ""; {
+		var page struct {
+			ListViews      []ListView `json:"listviews"`
+			NextRecordsUrl string     `json:"nextRecordsUrl"`
+		}
+
+		if err := c.doJSON(ctx, http.MethodGet, url, nil, &page); err != nil {
+			return nil, err
+		}
+
+		views = append(views, page.ListViews...)
+
+		url = ""
+		if page.NextRecordsUrl != "" {
+			url = c.resolveUrl(ctx, page.NextRecordsUrl)
+		}
+	}
+
+	return views, nil
+
+}
+
+/*
+ *	DescribeListView
+ *	Returns the definition of a list view, including its SOQL query, which may be run with QueryRecords.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeListView(ctx context.Context, object string, id string) (*ListViewDescribe, error) {
+
+	var describe ListViewDescribe
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "sobjects/"+object+"/listviews/"+id+"/describe"), nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
+
+/*
+ *	ListViewResults
+ *	Returns a page of the records of a list view, of up to limit records, from 1 to 2,000, after skipping offset records.
+ *	@since	2.0.0
+ */
+func (c *Client) ListViewResults(ctx context.Context, object string, id string, limit int, offset int) (*ListViewResult, error) {
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+
+	var result ListViewResult
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "sobjects/"+object+"/listviews/"+id+"/results?"+query.Encode()), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	ListViewRecords
+ *	Returns all records of a list view, retrieving them in pages of 2,000.
+ *	@since	2.0.0
+ */
+func (c *Client) ListViewRecords(ctx context.Context, object string, id string) ([]ListViewRecord, error) {
+
+	var records []ListViewRecord
+
+	for {
+		result, err := c.ListViewResults(ctx, object, id, 2000, len(records))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, result.Records...)
+
+		if result.Done || len(result.Records) == 0 {
+			return records, nil
+		}
+	}
+
+}
@@ -0,0 +1,243 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package metadata
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Metadata
+ *	A metadata component, e.g. a *CustomObject, created, updated, or read with the CRUD calls of the Metadata API.
+ *	@since	2.0.0
+ */
+type Metadata interface {
+	// Returns the name of the metadata type, e.g. CustomObject.
+	MetadataType() string
+}
+
+/*
+ *	metadataElement
+ *	A metadata element of a CRUD call, typed with xsi:type as SOAP requires.
+ *	@since	2.0.0
+ */
+type metadataElement struct {
+	Metadata
+}
+
+/*
+ *	MarshalXML
+ *	@since	2.0.0
+ */
+func (m metadataElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: m.MetadataType()})
+
+	return e.EncodeElement(m.Metadata, start)
+
+}
+
+/*
+ *	SaveResult
+ *	The result of creating, updating, or deleting a single metadata component.
+ *	@since	2.0.0
+ */
+type SaveResult struct {
+	FullName string  `xml:"fullName"`
+	Success  bool    `xml:"success"`
+	Created  bool    `xml:"created"`
+	Errors   []Error `xml:"errors"`
+}
+
+/*
+ *	Error
+ *	An error reported for a metadata component.
+ *	@since	2.0.0
+ */
+type Error struct {
+	StatusCode string   `xml:"statusCode"`
+	Message    string   `xml:"message"`
+	Fields     []string `xml:"fields"`
+}
+
+/*
+ *	Err
+ *	Returns the errors reported for the component as a *salesforce.SalesforceError,
+ *	or salesforce.SalesforceErrors for several, or nil if it was saved.
+ *	@since	2.0.0
+ */
+func (r *SaveResult) Err() error {
+
+	if r.Success || len(r.Errors) == 0 {
+		return nil
+	}
+
+	errs := make(salesforce.SalesforceErrors, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = &salesforce.SalesforceError{Message: e.Message, ErrorCode: e.StatusCode, Fields: e.Fields}
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	return errs
+
+}
+
+/*
+ *	saveMetadata
+ *	@since	2.0.0
+ */
+func (m *Client) saveMetadata(ctx context.Context, operation string, components []Metadata) ([]SaveResult, error) {
+
+	request := struct {
+		XMLName  xml.Name
+		Metadata []metadataElement `xml:"metadata"`
+	}{
+		XMLName: xml.Name{Space: Namespace, Local: operation},
+	}
+
+	for _, component := range components {
+		request.Metadata = append(request.Metadata, metadataElement{component})
+	}
+
+	var results []SaveResult
+
+	if err := m.call(ctx, request, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	CreateMetadata
+ *	Creates up to 10 metadata components of the same type in a single call.
+ *	The results are returned in the order of the components.
+ *	@since	2.0.0
+ */
+func (m *Client) CreateMetadata(ctx context.Context, components ...Metadata) ([]SaveResult, error) {
+
+	return m.saveMetadata(ctx, "createMetadata", components)
+
+}
+
+/*
+ *	UpdateMetadata
+ *	Replaces up to 10 existing metadata components of the same type in a single call.
+ *	The results are returned in the order of the components.
+ *	@since	2.0.0
+ */
+func (m *Client) UpdateMetadata(ctx context.Context, components ...Metadata) ([]SaveResult, error) {
+
+	return m.saveMetadata(ctx, "updateMetadata", components)
+
+}
+
+/*
+ *	UpsertMetadata
+ *	Creates or replaces up to 10 metadata components of the same type in a single call.
+ *	The results are returned in the order of the components; SaveResult.Created reports whether a component was created.
+ *	@since	2.0.0
+ */
+func (m *Client) UpsertMetadata(ctx context.Context, components ...Metadata) ([]SaveResult, error) {
+
+	return m.saveMetadata(ctx, "upsertMetadata", components)
+
+}
+
+/*
+ *	DeleteMetadata
+ *	Deletes up to 10 metadata components of the given type, e.g. CustomField, by full name, e.g. Account.Tier__c.
+ *	The results are returned in the order of the names.
+ *	@since	2.0.0
+ */
+func (m *Client) DeleteMetadata(ctx context.Context, metadataType string, fullNames ...string) ([]SaveResult, error) {
+
+	request := struct {
+		XMLName   xml.Name `xml:"http://soap.sforce.com/2006/04/metadata deleteMetadata"`
+		Type      string   `xml:"type"`
+		FullNames []string `xml:"fullNames"`
+	}{
+		Type:      metadataType,
+		FullNames: fullNames,
+	}
+
+	var results []SaveResult
+
+	if err := m.call(ctx, request, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	ReadMetadata
+ *	Returns up to 10 metadata components of type T by full name.
+ *	Components that do not exist are omitted.
+ *	@since	2.0.0
+ */
+func ReadMetadata[T Metadata](ctx context.Context, m *Client, fullNames ...string) ([]T, error) {
+
+	var zero T
+
+	request := struct {
+		XMLName   xml.Name `xml:"http://soap.sforce.com/2006/04/metadata readMetadata"`
+		Type      string   `xml:"type"`
+		FullNames []string `xml:"fullNames"`
+	}{
+		Type:      zero.MetadataType(),
+		FullNames: fullNames,
+	}
+
+	var result struct {
+		Records []struct {
+			FullName string `xml:"fullName"`
+			Inner    []byte `xml:",innerxml"`
+		} `xml:"records"`
+	}
+
+	if err := m.call(ctx, request, &result); err != nil {
+		return nil, err
+	}
+
+	components := make([]T, 0, len(result.Records))
+
+	for _, record := range result.Records {
+		if record.FullName == "" {
+			continue
+		}
+
+		var component T
+		if err := xml.Unmarshal([]byte("<records>"+string(record.Inner)+"</records>"), &component); err != nil {
+			return nil, err
+		}
+		components = append(components, component)
+	}
+
+	return components, nil
+
+}
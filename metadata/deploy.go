@@ -0,0 +1,300 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package metadata
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+ *	TestLevel
+ *	Selects the Apex tests run by a deployment.
+ *	@since	2.0.0
+ */
+type TestLevel string
+
+/*
+ *	Test levels of deployments.
+ *	@since	2.0.0
+ */
+const (
+	// No tests are run. Not allowed for deployments to production that contain Apex.
+	NoTestRun TestLevel = "NoTestRun"
+
+	// Only the tests listed in DeployOptions.RunTests are run.
+	RunSpecifiedTests TestLevel = "RunSpecifiedTests"
+
+	// All tests in the org are run, except those of installed managed packages.
+	RunLocalTests TestLevel = "RunLocalTests"
+
+	// All tests in the org are run, including those of installed managed packages.
+	RunAllTestsInOrg TestLevel = "RunAllTestsInOrg"
+)
+
+/*
+ *	Statuses of deployments and retrievals.
+ *	@since	2.0.0
+ */
+const (
+	StatusPending          string = "Pending"
+	StatusInProgress       string = "InProgress"
+	StatusSucceeded        string = "Succeeded"
+	StatusSucceededPartial string = "SucceededPartial"
+	StatusFailed           string = "Failed"
+	StatusCanceling        string = "Canceling"
+	StatusCanceled         string = "Canceled"
+)
+
+/*
+ *	DeployOptions
+ *	Controls how a deployment is validated and applied.
+ *	Production deployments normally set RollbackOnError.
+ *	@since	2.0.0
+ */
+type DeployOptions struct {
+	AllowMissingFiles bool      `xml:"allowMissingFiles"`
+	AutoUpdatePackage bool      `xml:"autoUpdatePackage"`
+	CheckOnly         bool      `xml:"checkOnly"`
+	IgnoreWarnings    bool      `xml:"ignoreWarnings"`
+	PerformRetrieve   bool      `xml:"performRetrieve"`
+	PurgeOnDelete     bool      `xml:"purgeOnDelete"`
+	RollbackOnError   bool      `xml:"rollbackOnError"`
+	RunTests          []string  `xml:"runTests"`
+	SinglePackage     bool      `xml:"singlePackage"`
+	TestLevel         TestLevel `xml:"testLevel,omitempty"`
+}
+
+/*
+ *	DeployResult
+ *	The status of a deployment. Details are only returned when requested.
+ *	@since	2.0.0
+ */
+type DeployResult struct {
+	Id                       string        `xml:"id"`
+	Done                     bool          `xml:"done"`
+	Status                   string        `xml:"status"`
+	Success                  bool          `xml:"success"`
+	CheckOnly                bool          `xml:"checkOnly"`
+	StateDetail              string        `xml:"stateDetail"`
+	ErrorMessage             string        `xml:"errorMessage"`
+	ErrorStatusCode          string        `xml:"errorStatusCode"`
+	NumberComponentsDeployed int           `xml:"numberComponentsDeployed"`
+	NumberComponentErrors    int           `xml:"numberComponentErrors"`
+	NumberComponentsTotal    int           `xml:"numberComponentsTotal"`
+	NumberTestsCompleted     int           `xml:"numberTestsCompleted"`
+	NumberTestErrors         int           `xml:"numberTestErrors"`
+	NumberTestsTotal         int           `xml:"numberTestsTotal"`
+	Details                  DeployDetails `xml:"details"`
+}
+
+/*
+ *	DeployDetails
+ *	The outcome of a deployment for each component and test.
+ *	@since	2.0.0
+ */
+type DeployDetails struct {
+	ComponentFailures  []DeployMessage `xml:"componentFailures"`
+	ComponentSuccesses []DeployMessage `xml:"componentSuccesses"`
+	RunTestResult      RunTestsResult  `xml:"runTestResult"`
+}
+
+/*
+ *	DeployMessage
+ *	The outcome of a deployment for a single component.
+ *	@since	2.0.0
+ */
+type DeployMessage struct {
+	ComponentType string `xml:"componentType"`
+	FullName      string `xml:"fullName"`
+	FileName      string `xml:"fileName"`
+	Success       bool   `xml:"success"`
+	Created       bool   `xml:"created"`
+	Changed       bool   `xml:"changed"`
+	Deleted       bool   `xml:"deleted"`
+	Problem       string `xml:"problem"`
+	ProblemType   string `xml:"problemType"`
+	LineNumber    int    `xml:"lineNumber"`
+	ColumnNumber  int    `xml:"columnNumber"`
+}
+
+/*
+ *	RunTestsResult
+ *	The outcome of the Apex tests run by a deployment.
+ *	@since	2.0.0
+ */
+type RunTestsResult struct {
+	NumTestsRun int           `xml:"numTestsRun"`
+	NumFailures int           `xml:"numFailures"`
+	TotalTime   float64       `xml:"totalTime"`
+	Failures    []TestFailure `xml:"failures"`
+}
+
+/*
+ *	TestFailure
+ *	A failed Apex test method.
+ *	@since	2.0.0
+ */
+type TestFailure struct {
+	Name       string  `xml:"name"`
+	MethodName string  `xml:"methodName"`
+	Message    string  `xml:"message"`
+	StackTrace string  `xml:"stackTrace"`
+	Time       float64 `xml:"time"`
+}
+
+/*
+ *	Err
+ *	Returns an error describing why the deployment failed, or nil if it succeeded.
+ *	@since	2.0.0
+ */
+func (r *DeployResult) Err() error {
+
+	if r.Status == StatusSucceeded {
+		return nil
+	}
+
+	problems := []string{"metadata: deploy " + strings.ToLower(r.Status)}
+
+	if r.ErrorMessage != "" {
+		problems = append(problems, r.ErrorMessage)
+	}
+
+	for _, failure := range r.Details.ComponentFailures {
+		problems = append(problems, fmt.Sprintf("%s %s (line %d): %s", failure.ComponentType, failure.FullName, failure.LineNumber, failure.Problem))
+	}
+
+	for _, failure := range r.Details.RunTestResult.Failures {
+		problems = append(problems, fmt.Sprintf("test %s.%s: %s", failure.Name, failure.MethodName, failure.Message))
+	}
+
+	return errors.New(strings.Join(problems, "; "))
+
+}
+
+/*
+ *	Deploy
+ *	Starts deploying a zip file of metadata, e.g. made with ZipDirectory, and returns the ID of the deployment.
+ *	Use CheckDeployStatus or WaitForDeploy to follow it.
+ *	@since	2.0.0
+ */
+func (m *Client) Deploy(ctx context.Context, zipFile []byte, options DeployOptions) (string, error) {
+
+	operation := struct {
+		XMLName       xml.Name      `xml:"http://soap.sforce.com/2006/04/metadata deploy"`
+		ZipFile       string        `xml:"ZipFile"`
+		DeployOptions DeployOptions `xml:"DeployOptions"`
+	}{
+		ZipFile:       base64.StdEncoding.EncodeToString(zipFile),
+		DeployOptions: options,
+	}
+
+	var result struct {
+		Id string `xml:"id"`
+	}
+
+	if err := m.call(ctx, operation, &result); err != nil {
+		return "", err
+	}
+
+	return result.Id, nil
+
+}
+
+/*
+ *	CheckDeployStatus
+ *	Returns the status of a deployment, with the outcome of each component and test if includeDetails is set.
+ *	@since	2.0.0
+ */
+func (m *Client) CheckDeployStatus(ctx context.Context, id string, includeDetails bool) (*DeployResult, error) {
+
+	operation := struct {
+		XMLName        xml.Name `xml:"http://soap.sforce.com/2006/04/metadata checkDeployStatus"`
+		AsyncProcessId string   `xml:"asyncProcessId"`
+		IncludeDetails bool     `xml:"includeDetails"`
+	}{
+		AsyncProcessId: id,
+		IncludeDetails: includeDetails,
+	}
+
+	var result DeployResult
+
+	if err := m.call(ctx, operation, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	CancelDeploy
+ *	Requests the cancellation of a deployment in progress.
+ *	@since	2.0.0
+ */
+func (m *Client) CancelDeploy(ctx context.Context, id string) error {
+
+	operation := struct {
+		XMLName xml.Name `xml:"http://soap.sforce.com/2006/04/metadata cancelDeploy"`
+		Id      string   `xml:"String"`
+	}{
+		Id: id,
+	}
+
+	return m.call(ctx, operation, nil)
+
+}
+
+/*
+ *	WaitForDeploy
+ *	Polls a deployment every interval until it is done, and returns its status with details.
+ *	Returns an error, along with the status, if the deployment did not succeed.
+ *	@since	2.0.0
+ */
+func (m *Client) WaitForDeploy(ctx context.Context, id string, interval time.Duration) (*DeployResult, error) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := m.CheckDeployStatus(ctx, id, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Done {
+			if result, err = m.CheckDeployStatus(ctx, id, true); err != nil {
+				return nil, err
+			}
+			return result, result.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+}
@@ -0,0 +1,153 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package metadata
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Namespace of the Metadata API.
+ *	@since	2.0.0
+ */
+const Namespace string = "http://soap.sforce.com/2006/04/metadata"
+
+/*
+ *	Client
+ *	A client for the Salesforce Metadata API, used to deploy and retrieve metadata.
+ *	A Client is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
+ */
+type Client struct {
+	// Client of the org, used to send requests.
+	client *salesforce.Client
+}
+
+/*
+ *	NewClient
+ *	Returns a Metadata API client sending requests through the given client,
+ *	with its authentication, API version, and options.
+ *	@since	2.0.0
+ */
+func NewClient(client *salesforce.Client) *Client {
+
+	return &Client{client: client}
+
+}
+
+/*
+ *	apiVersion
+ *	Returns the version of the Metadata API, e.g. "61.0", matching the version of the client
+ *	or the version set on the context with ContextWithApiVersion.
+ *	@since	2.0.0
+ */
+func (m *Client) apiVersion(ctx context.Context) string {
+
+	return strings.TrimPrefix(m.client.ApiVersionFor(ctx), "v")
+
+}
+
+/*
+ *	call
+ *	Calls a Metadata API operation with the given request element,
+ *	and decodes the result element of the response into result, if not nil,
+ *	or every result element if result points to a slice.
+ *	Faults are returned as a *salesforce.SalesforceError.
+ *	@since	2.0.0
+ */
+func (m *Client) call(ctx context.Context, operation interface{}, result interface{}) error {
+
+	body, err := xml.Marshal(operation)
+	if err != nil {
+		return err
+	}
+
+	// The session ID is the access token without its type.
+	sessionId := m.client.OAuth2AccessToken()
+	if _, token, ok := strings.Cut(sessionId, " "); ok {
+		sessionId = token
+	}
+
+	var envelope bytes.Buffer
+
+	envelope.WriteString(xml.Header)
+	envelope.WriteString(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`)
+	envelope.WriteString(`<soapenv:Header><SessionHeader xmlns="` + Namespace + `"><sessionId>`)
+	xml.EscapeText(&envelope, []byte(sessionId))
+	envelope.WriteString(`</sessionId></SessionHeader></soapenv:Header>`)
+	envelope.WriteString(`<soapenv:Body>`)
+	envelope.Write(body)
+	envelope.WriteString(`</soapenv:Body></soapenv:Envelope>`)
+
+	request, err := m.client.NewRequest(ctx, http.MethodPost, "/services/Soap/m/"+m.apiVersion(ctx), &envelope)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "text/xml")
+	request.Header.Set("Content-Type", "text/xml; charset=UTF-8")
+	request.Header.Set("SOAPAction", `""`)
+
+	response, err := m.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if result == nil {
+		return nil
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	var decoded struct {
+		Body struct {
+			Response struct {
+				Inner []byte `xml:",innerxml"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	// Decoding into a slice collects every result element.
+	decoder := xml.NewDecoder(bytes.NewReader(decoded.Body.Response.Inner))
+
+	for {
+		if err := decoder.Decode(result); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+}
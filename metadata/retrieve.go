@@ -0,0 +1,246 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package metadata
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+/*
+ *	Package
+ *	A manifest of metadata components, as in a package.xml file.
+ *	@since	2.0.0
+ */
+type Package struct {
+	Types   []PackageTypeMembers `xml:"types"`
+	Version string               `xml:"version,omitempty"`
+}
+
+/*
+ *	PackageTypeMembers
+ *	The components of a single metadata type, e.g. ApexClass, in a manifest.
+ *	Use "*" as a member to select all components of the type.
+ *	@since	2.0.0
+ */
+type PackageTypeMembers struct {
+	Members []string `xml:"members"`
+	Name    string   `xml:"name"`
+}
+
+/*
+ *	PackageXml
+ *	Returns the manifest as the contents of a package.xml file.
+ *	@since	2.0.0
+ */
+func (p *Package) PackageXml() ([]byte, error) {
+
+	manifest := struct {
+		XMLName xml.Name `xml:"http://soap.sforce.com/2006/04/metadata Package"`
+		*Package
+	}{Package: p}
+
+	data, err := xml.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), data...), nil
+
+}
+
+/*
+ *	RetrieveRequest
+ *	Selects the metadata to retrieve: named packages, specific files, or the components of a manifest.
+ *	ApiVersion defaults to the version of the client.
+ *	@since	2.0.0
+ */
+type RetrieveRequest struct {
+	ApiVersion    string   `xml:"apiVersion,omitempty"`
+	PackageNames  []string `xml:"packageNames"`
+	SinglePackage bool     `xml:"singlePackage"`
+	SpecificFiles []string `xml:"specificFiles"`
+	Unpackaged    *Package `xml:"unpackaged"`
+}
+
+/*
+ *	RetrieveResult
+ *	The status of a retrieval, and the retrieved zip file once it is done.
+ *	@since	2.0.0
+ */
+type RetrieveResult struct {
+	Id              string            `xml:"id"`
+	Done            bool              `xml:"done"`
+	Status          string            `xml:"status"`
+	Success         bool              `xml:"success"`
+	ErrorMessage    string            `xml:"errorMessage"`
+	ErrorStatusCode string            `xml:"errorStatusCode"`
+	FileProperties  []FileProperties  `xml:"fileProperties"`
+	Messages        []RetrieveMessage `xml:"messages"`
+	ZipFile         []byte            `xml:"-"`
+}
+
+/*
+ *	FileProperties
+ *	The properties of a retrieved component.
+ *	@since	2.0.0
+ */
+type FileProperties struct {
+	Id                 string `xml:"id"`
+	Type               string `xml:"type"`
+	FullName           string `xml:"fullName"`
+	FileName           string `xml:"fileName"`
+	NamespacePrefix    string `xml:"namespacePrefix"`
+	ManageableState    string `xml:"manageableState"`
+	CreatedById        string `xml:"createdById"`
+	CreatedByName      string `xml:"createdByName"`
+	CreatedDate        string `xml:"createdDate"`
+	LastModifiedById   string `xml:"lastModifiedById"`
+	LastModifiedByName string `xml:"lastModifiedByName"`
+	LastModifiedDate   string `xml:"lastModifiedDate"`
+}
+
+/*
+ *	RetrieveMessage
+ *	A problem with a component that could not be retrieved.
+ *	@since	2.0.0
+ */
+type RetrieveMessage struct {
+	FileName string `xml:"fileName"`
+	Problem  string `xml:"problem"`
+}
+
+/*
+ *	Err
+ *	Returns an error describing why the retrieval failed, or nil if it succeeded.
+ *	@since	2.0.0
+ */
+func (r *RetrieveResult) Err() error {
+
+	if r.Status == StatusSucceeded {
+		return nil
+	}
+
+	return errors.New("metadata: retrieve " + r.Status + ": " + r.ErrorMessage)
+
+}
+
+/*
+ *	Retrieve
+ *	Starts retrieving metadata and returns the ID of the retrieval.
+ *	Use CheckRetrieveStatus or WaitForRetrieve to follow it.
+ *	@since	2.0.0
+ */
+func (m *Client) Retrieve(ctx context.Context, request RetrieveRequest) (string, error) {
+
+	if request.ApiVersion == "" {
+		request.ApiVersion = m.apiVersion(ctx)
+	}
+
+	operation := struct {
+		XMLName         xml.Name        `xml:"http://soap.sforce.com/2006/04/metadata retrieve"`
+		RetrieveRequest RetrieveRequest `xml:"retrieveRequest"`
+	}{
+		RetrieveRequest: request,
+	}
+
+	var result struct {
+		Id string `xml:"id"`
+	}
+
+	if err := m.call(ctx, operation, &result); err != nil {
+		return "", err
+	}
+
+	return result.Id, nil
+
+}
+
+/*
+ *	CheckRetrieveStatus
+ *	Returns the status of a retrieval, with the retrieved zip file if includeZip is set and the retrieval is done.
+ *	@since	2.0.0
+ */
+func (m *Client) CheckRetrieveStatus(ctx context.Context, id string, includeZip bool) (*RetrieveResult, error) {
+
+	operation := struct {
+		XMLName        xml.Name `xml:"http://soap.sforce.com/2006/04/metadata checkRetrieveStatus"`
+		AsyncProcessId string   `xml:"asyncProcessId"`
+		IncludeZip     bool     `xml:"includeZip"`
+	}{
+		AsyncProcessId: id,
+		IncludeZip:     includeZip,
+	}
+
+	var result struct {
+		RetrieveResult
+		ZipFile string `xml:"zipFile"`
+	}
+
+	if err := m.call(ctx, operation, &result); err != nil {
+		return nil, err
+	}
+
+	if result.ZipFile != "" {
+		zipFile, err := base64.StdEncoding.DecodeString(result.ZipFile)
+		if err != nil {
+			return nil, err
+		}
+		result.RetrieveResult.ZipFile = zipFile
+	}
+
+	return &result.RetrieveResult, nil
+
+}
+
+/*
+ *	WaitForRetrieve
+ *	Polls a retrieval every interval until it is done, and returns its status with the retrieved zip file.
+ *	Returns an error, along with the status, if the retrieval did not succeed.
+ *	@since	2.0.0
+ */
+func (m *Client) WaitForRetrieve(ctx context.Context, id string, interval time.Duration) (*RetrieveResult, error) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := m.CheckRetrieveStatus(ctx, id, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Done {
+			if result, err = m.CheckRetrieveStatus(ctx, id, true); err != nil {
+				return nil, err
+			}
+			return result, result.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+}
@@ -0,0 +1,181 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package metadata
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	CustomObject
+ *	The definition of a custom object, e.g. Invoice__c.
+ *	DeploymentStatus is Deployed or InDevelopment; SharingModel is ReadWrite, Read, Private, or ControlledByParent.
+ *	@since	2.0.0
+ */
+type CustomObject struct {
+	FullName         string        `xml:"fullName"`
+	DeploymentStatus string        `xml:"deploymentStatus,omitempty"`
+	Description      string        `xml:"description,omitempty"`
+	EnableActivities bool          `xml:"enableActivities,omitempty"`
+	EnableHistory    bool          `xml:"enableHistory,omitempty"`
+	EnableReports    bool          `xml:"enableReports,omitempty"`
+	EnableSearch     bool          `xml:"enableSearch,omitempty"`
+	Fields           []CustomField `xml:"fields"`
+	Label            string        `xml:"label"`
+	NameField        *CustomField  `xml:"nameField"`
+	PluralLabel      string        `xml:"pluralLabel"`
+	SharingModel     string        `xml:"sharingModel,omitempty"`
+}
+
+/*
+ *	MetadataType
+ *	@since	2.0.0
+ */
+func (CustomObject) MetadataType() string {
+
+	return "CustomObject"
+
+}
+
+/*
+ *	CustomField
+ *	The definition of a custom field. FullName includes the object, e.g. Account.Tier__c,
+ *	except for the fields of a CustomObject.
+ *	Type is e.g. Text, Number, Checkbox, Date, Picklist, Lookup, or MasterDetail.
+ *	Text fields need a Length, Number fields a Precision, and Checkbox fields a DefaultValue.
+ *	@since	2.0.0
+ */
+type CustomField struct {
+	FullName          string    `xml:"fullName,omitempty"`
+	CaseSensitive     bool      `xml:"caseSensitive,omitempty"`
+	DefaultValue      string    `xml:"defaultValue,omitempty"`
+	DeleteConstraint  string    `xml:"deleteConstraint,omitempty"`
+	Description       string    `xml:"description,omitempty"`
+	DisplayFormat     string    `xml:"displayFormat,omitempty"`
+	ExternalId        bool      `xml:"externalId,omitempty"`
+	InlineHelpText    string    `xml:"inlineHelpText,omitempty"`
+	Label             string    `xml:"label"`
+	Length            int       `xml:"length,omitempty"`
+	Precision         int       `xml:"precision,omitempty"`
+	ReferenceTo       string    `xml:"referenceTo,omitempty"`
+	RelationshipLabel string    `xml:"relationshipLabel,omitempty"`
+	RelationshipName  string    `xml:"relationshipName,omitempty"`
+	Required          bool      `xml:"required,omitempty"`
+	Scale             int       `xml:"scale,omitempty"`
+	Type              string    `xml:"type"`
+	Unique            bool      `xml:"unique,omitempty"`
+	ValueSet          *ValueSet `xml:"valueSet"`
+	VisibleLines      int       `xml:"visibleLines,omitempty"`
+}
+
+/*
+ *	MetadataType
+ *	@since	2.0.0
+ */
+func (CustomField) MetadataType() string {
+
+	return "CustomField"
+
+}
+
+/*
+ *	ValueSet
+ *	The values of a picklist field.
+ *	@since	2.0.0
+ */
+type ValueSet struct {
+	ControllingField   string             `xml:"controllingField,omitempty"`
+	Restricted         bool               `xml:"restricted,omitempty"`
+	ValueSetDefinition ValueSetDefinition `xml:"valueSetDefinition"`
+}
+
+/*
+ *	ValueSetDefinition
+ *	@since	2.0.0
+ */
+type ValueSetDefinition struct {
+	Sorted bool          `xml:"sorted"`
+	Value  []CustomValue `xml:"value"`
+}
+
+/*
+ *	CustomValue
+ *	A value of a picklist field.
+ *	@since	2.0.0
+ */
+type CustomValue struct {
+	FullName string `xml:"fullName"`
+	Default  bool   `xml:"default"`
+	Label    string `xml:"label"`
+}
+
+/*
+ *	SetFieldPermissions
+ *	Grants or revokes read and edit access to a field, e.g. Account.Tier__c, through a permission set.
+ *	For a profile, use the ID of the permission set owned by the profile.
+ *	New custom fields are hidden from every profile until access is granted.
+ *	@since	2.0.0
+ */
+func (m *Client) SetFieldPermissions(ctx context.Context, permissionSetId string, field string, readable bool, editable bool) error {
+
+	object, _, _ := strings.Cut(field, ".")
+
+	query, err := soql.Format("SELECT Id FROM FieldPermissions WHERE ParentId = ? AND Field = ?", permissionSetId, field)
+	if err != nil {
+		return err
+	}
+
+	result, err := m.client.QueryRecords(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	permissions := map[string]interface{}{
+		"PermissionsRead": readable || editable,
+		"PermissionsEdit": editable,
+	}
+
+	if len(result.Records) == 0 {
+		permissions["ParentId"] = permissionSetId
+		permissions["SobjectType"] = object
+		permissions["Field"] = field
+		_, err := m.client.Create(ctx, "FieldPermissions", permissions)
+		return err
+	}
+
+	var existing struct {
+		Id string `json:"Id"`
+	}
+
+	if err := json.Unmarshal(result.Records[0], &existing); err != nil {
+		return err
+	}
+
+	// Revoking read access entirely requires deleting the permissions.
+	if !readable && !editable {
+		return m.client.Delete(ctx, "FieldPermissions", existing.Id)
+	}
+
+	return m.client.Update(ctx, "FieldPermissions", existing.Id, permissions)
+
+}
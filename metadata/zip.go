@@ -0,0 +1,149 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package metadata
+
+// Import standard packages.
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+ *	ZipDirectory
+ *	Returns a zip file of the files in a directory, for deployment.
+ *	The directory holds package.xml and the metadata folders, e.g. classes and objects,
+ *	or, for several packages, a folder for each.
+ *	@since	2.0.0
+ */
+func ZipDirectory(dir string) ([]byte, error) {
+
+	var buffer bytes.Buffer
+
+	archive := zip.NewWriter(&buffer)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		writer, err := archive.Create(filepath.ToSlash(name))
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		_, err = io.Copy(writer, file)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+
+}
+
+/*
+ *	ExtractZip
+ *	Writes the files of a zip file, e.g. the result of a retrieval, into a directory.
+ *	@since	2.0.0
+ */
+func ExtractZip(zipFile []byte, dir string) error {
+
+	archive, err := zip.NewReader(bytes.NewReader(zipFile), int64(len(zipFile)))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range archive.File {
+		path := filepath.Join(dir, filepath.FromSlash(file.Name))
+
+		// Never write outside the directory.
+		if rel, err := filepath.Rel(dir, path); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return errors.New("metadata: invalid file name in zip: " + file.Name)
+		}
+
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(path, 0o755); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := extractFile(file, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	extractFile
+ *	@since	2.0.0
+ */
+func extractFile(file *zip.File, path string) error {
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+
+	writer, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+
+}
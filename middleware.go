@@ -0,0 +1,85 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"net/http"
+)
+
+/*
+ *	RoundTripperFunc
+ *	Sends a single HTTP request and returns its response.
+ *	@since	2.0.0
+ */
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+/*
+ *	RoundTrip
+ *	Implements http.RoundTripper.
+ *	@since	2.0.0
+ */
+func (f RoundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+
+	return f(request)
+
+}
+
+/*
+ *	Middleware
+ *	Wraps the sending of requests, e.g. to add headers, log, or record metrics.
+ *	A middleware calls next to send the request on, and may inspect or replace the response.
+ *	@since	2.0.0
+ */
+type Middleware func(next RoundTripperFunc) RoundTripperFunc
+
+/*
+ *	WithMiddleware
+ *	Adds middleware around every HTTP request sent by the client, including retries and token requests.
+ *	The first middleware given is the outermost; options may be repeated to add more.
+ *	@since	2.0.0
+ */
+func WithMiddleware(middleware ...Middleware) Option {
+
+	return func(c *Client) {
+		c.middleware = append(c.middleware, middleware...)
+	}
+
+}
+
+/*
+ *	chain
+ *	Returns the function sending requests through the middleware of the client.
+ *	Compression is applied innermost, so middleware sees uncompressed bodies.
+ *	@since	2.0.0
+ */
+func (c *Client) chain() RoundTripperFunc {
+
+	next := RoundTripperFunc(c.httpClient.Do)
+
+	if c.compression {
+		next = gzipMiddleware(c.compressionMinSize)(next)
+	}
+
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+
+	return next
+
+}
@@ -0,0 +1,215 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	Channels of Real-Time Event Monitoring events, for the streaming and pubsub clients.
+ *	Events are stored in the big objects named alike without the Stream suffix, e.g. LoginEvent.
+ *	@since	2.0.0
+ */
+const (
+	LoginEventStream        = "/event/LoginEventStream"
+	LogoutEventStream       = "/event/LogoutEventStream"
+	ApiEventStream          = "/event/ApiEventStream"
+	ReportEventStream       = "/event/ReportEventStream"
+	ListViewEventStream     = "/event/ListViewEventStream"
+	LightningUriEventStream = "/event/LightningUriEventStream"
+)
+
+/*
+ *	EventTime
+ *	The time of a monitoring event, decoded from date-time strings of the REST and Streaming APIs
+ *	or epoch milliseconds of the Pub/Sub API.
+ *	@since	2.0.0
+ */
+type EventTime time.Time
+
+/*
+ *	Time
+ *	Returns the event time as a time.Time.
+ *	@since	2.0.0
+ */
+func (t EventTime) Time() time.Time {
+
+	return time.Time(t)
+
+}
+
+/*
+ *	MarshalJSON
+ *	Encodes the event time as an RFC 3339 string.
+ *	@since	2.0.0
+ */
+func (t EventTime) MarshalJSON() ([]byte, error) {
+
+	return json.Marshal(time.Time(t))
+
+}
+
+/*
+ *	UnmarshalJSON
+ *	Decodes a date-time string, e.g. 2024-01-02T03:04:05.000+0000, or a number of epoch milliseconds.
+ *	@since	2.0.0
+ */
+func (t *EventTime) UnmarshalJSON(data []byte) error {
+
+	if bytes.Equal(data, []byte("null")) {
+		*t = EventTime{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '"' {
+		var millis int64
+		if err := json.Unmarshal(data, &millis); err != nil {
+			return err
+		}
+		*t = EventTime(time.UnixMilli(millis).UTC())
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	parsed, err := time.Parse("2006-01-02T15:04:05.000-0700", value)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339Nano, value)
+	}
+	if err != nil {
+		return err
+	}
+
+	*t = EventTime(parsed)
+	return nil
+
+}
+
+/*
+ *	LoginEvent
+ *	A login to the org, received on LoginEventStream or queried from LoginEvent.
+ *	Streaming events decode from their Payload, and Pub/Sub events with DecodeInto.
+ *	@since	2.0.0
+ */
+type LoginEvent struct {
+	EventIdentifier string
+	EventDate       EventTime
+	UserId          string
+	Username        string
+	SessionKey      string
+	LoginKey        string
+
+	// Success, or the reason of a failed login, e.g. Invalid Password.
+	Status      string
+	LoginType   string
+	LoginUrl    string
+	SourceIp    string
+	CountryIso  string
+	City        string
+	Application string
+	Browser     string
+	Platform    string
+}
+
+/*
+ *	ApiEvent
+ *	An API query, received on ApiEventStream or queried from ApiEvent.
+ *	Streaming events decode from their Payload, and Pub/Sub events with DecodeInto.
+ *	@since	2.0.0
+ */
+type ApiEvent struct {
+	EventIdentifier string
+	EventDate       EventTime
+	UserId          string
+	Username        string
+	SessionKey      string
+	LoginKey        string
+	SourceIp        string
+	Application     string
+	Client          string
+	ApiType         string
+	ApiVersion      float64
+	Operation       string
+
+	// The SOQL query, the comma-separated objects it queried, and the number of rows it returned.
+	Query           string
+	QueriedEntities string
+	RowsProcessed   float64
+
+	// JSON of the IDs of the records returned.
+	Records string
+}
+
+/*
+ *	LoginEvents
+ *	Returns the stored login events of a time window.
+ *	@since	2.0.0
+ */
+func (c *Client) LoginEvents(ctx context.Context, start time.Time, end time.Time) ([]LoginEvent, error) {
+
+	return queryMonitoringEvents[LoginEvent](ctx, c, "LoginEvent", start, end)
+
+}
+
+/*
+ *	ApiEvents
+ *	Returns the stored API events of a time window.
+ *	@since	2.0.0
+ */
+func (c *Client) ApiEvents(ctx context.Context, start time.Time, end time.Time) ([]ApiEvent, error) {
+
+	return queryMonitoringEvents[ApiEvent](ctx, c, "ApiEvent", start, end)
+
+}
+
+/*
+ *	queryMonitoringEvents
+ *	Queries the fields of T from a storage object of monitoring events within a time window.
+ *	Storage objects are big objects, so they are filtered on their EventDate index and cannot be sorted.
+ *	@since	2.0.0
+ */
+func queryMonitoringEvents[T any](ctx context.Context, c *Client, object string, start time.Time, end time.Time) ([]T, error) {
+
+	var fields []string
+
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < rt.NumField(); i++ {
+		fields = append(fields, rt.Field(i).Name)
+	}
+
+	statement, err := soql.Format("SELECT "+strings.Join(fields, ", ")+" FROM "+object+
+		" WHERE EventDate >= ? AND EventDate < ?", start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return QueryInto[T](ctx, c, statement)
+
+}
@@ -0,0 +1,136 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+/*
+ *	Organization
+ *	The org of the authenticated user and its regional settings.
+ *	@since	2.0.0
+ */
+type Organization struct {
+	Id              string `sf:"Id"`
+	Name            string `sf:"Name"`
+	NamespacePrefix string `sf:"NamespacePrefix"`
+
+	// Edition of the org, e.g. Enterprise Edition or Developer Edition.
+	OrganizationType string `sf:"OrganizationType"`
+	InstanceName     string `sf:"InstanceName"`
+	IsSandbox        bool   `sf:"IsSandbox"`
+
+	// Date-time at which a trial org expires, or "" if it is not a trial.
+	TrialExpirationDate string `sf:"TrialExpirationDate"`
+
+	DefaultLocaleSidKey  string `sf:"DefaultLocaleSidKey"`
+	LanguageLocaleKey    string `sf:"LanguageLocaleKey"`
+	TimeZoneSidKey       string `sf:"TimeZoneSidKey"`
+	FiscalYearStartMonth int    `sf:"FiscalYearStartMonth"`
+
+	// Currency of the org, which is its corporate currency if multiple currencies are enabled.
+	CurrencyIsoCode string `json:"-"`
+	MultiCurrency   bool   `json:"-"`
+
+	// Instance URL of the client.
+	InstanceUrl string `json:"-"`
+}
+
+/*
+ *	GetOrganization
+ *	Returns the org of the authenticated user with its currency settings, in a single Composite Batch call.
+ *	@since	2.0.0
+ */
+func (c *Client) GetOrganization(ctx context.Context) (*Organization, error) {
+
+	query := func(soql string) BatchSubrequest {
+		return BatchSubrequest{Method: http.MethodGet, Url: "query?q=" + url.QueryEscape(soql)}
+	}
+
+	// The currency queries fail independently, on orgs without multiple currencies
+	// or whose edition lacks the field.
+	result, err := c.CompositeBatch(ctx, false,
+		query(`SELECT Id, Name, NamespacePrefix, OrganizationType, InstanceName, IsSandbox, TrialExpirationDate,
+			DefaultLocaleSidKey, LanguageLocaleKey, TimeZoneSidKey, FiscalYearStartMonth FROM Organization`),
+		query("SELECT DefaultCurrencyIsoCode FROM Organization"),
+		query("SELECT IsoCode FROM CurrencyType WHERE IsCorporate = true"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Results) != 3 {
+		return nil, errors.New("salesforce: unexpected number of batch results")
+	}
+
+	var organization Organization
+
+	if ok, err := decodeBatchRecord(&result.Results[0], &organization); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errors.New("salesforce: organization not found")
+	}
+
+	var currency struct {
+		DefaultCurrencyIsoCode string
+		IsoCode                string
+	}
+
+	if ok, _ := decodeBatchRecord(&result.Results[2], &currency); ok {
+		organization.MultiCurrency = true
+		organization.CurrencyIsoCode = currency.IsoCode
+	} else if ok, _ := decodeBatchRecord(&result.Results[1], &currency); ok {
+		organization.CurrencyIsoCode = currency.DefaultCurrencyIsoCode
+	}
+
+	organization.InstanceUrl = c.InstanceUrl()
+
+	return &organization, nil
+
+}
+
+/*
+ *	decodeBatchRecord
+ *	Decodes the first record of a query subrequest of a Composite Batch request into v,
+ *	and reports whether there was one.
+ *	@since	2.0.0
+ */
+func decodeBatchRecord(result *BatchSubresult, v interface{}) (bool, error) {
+
+	if err := result.Err(); err != nil {
+		return false, err
+	}
+
+	var page QueryResult
+	if err := json.Unmarshal(result.Result, &page); err != nil {
+		return false, err
+	}
+
+	if len(page.Records) == 0 {
+		return false, nil
+	}
+
+	return true, decodeRecord(page.Records[0], v)
+
+}
@@ -0,0 +1,154 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package outbound
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	Namespace of Outbound Messages.
+ *	@since	2.0.0
+ */
+const Namespace string = "http://soap.sforce.com/2005/09/outbound"
+
+/*
+ *	Maximum size of the body of an Outbound Message accepted by a Handler.
+ *	@since	2.0.0
+ */
+const maxBodySize int64 = 10 << 20
+
+/*
+ *	Message
+ *	An Outbound Message sent by a workflow rule or flow, with up to 100 notifications.
+ *	@since	2.0.0
+ */
+type Message struct {
+	OrganizationId string
+	ActionId       string
+
+	// Session ID of the user sending the message, if the outbound message is configured to send it.
+	// It can be used as the access token of a salesforce.Client, with the PartnerUrl as its instance URL.
+	SessionId string
+
+	EnterpriseUrl string
+	PartnerUrl    string
+
+	Notifications []Notification
+}
+
+/*
+ *	Notification
+ *	A notification of an Outbound Message, with the record that triggered it.
+ *	@since	2.0.0
+ */
+type Notification struct {
+	// ID of the notification, identical when Salesforce resends the notification.
+	Id string
+
+	// Type of the record, e.g. Account.
+	Type string
+
+	// Fields of the record selected in the outbound message, by API name; null fields are omitted.
+	Fields map[string]string
+}
+
+/*
+ *	Handler
+ *	An http.Handler receiving Outbound Messages.
+ *	@since	2.0.0
+ */
+type Handler struct {
+	// ID of the org allowed to send messages.
+	orgId string
+
+	// Called with each message received.
+	callback func(ctx context.Context, message *Message) error
+}
+
+/*
+ *	NewHandler
+ *	Returns an http.Handler that receives the Outbound Messages of the given org, 15 or 18 characters long,
+ *	and calls callback with each. Messages are acknowledged if callback returns nil; otherwise,
+ *	or if the message is invalid or comes from another org, Salesforce retries the message later, for up to 24 hours.
+ *	Salesforce may send a message more than once, so callback should ignore notifications already processed.
+ *	@since	2.0.0
+ */
+func NewHandler(orgId string, callback func(ctx context.Context, message *Message) error) *Handler {
+
+	return &Handler{orgId: orgId, callback: callback}
+
+}
+
+/*
+ *	ServeHTTP
+ *	@since	2.0.0
+ */
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeFault(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	message, err := Parse(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		writeFault(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Org IDs are compared on their case-sensitive 15 characters.
+	if len(h.orgId) < 15 || len(message.OrganizationId) < 15 || h.orgId[:15] != message.OrganizationId[:15] {
+		writeFault(w, http.StatusForbidden, "unexpected organization ID")
+		return
+	}
+
+	if err := h.callback(r.Context(), message); err != nil {
+		writeFault(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=UTF-8")
+	w.Write([]byte(xml.Header + `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">` +
+		`<soapenv:Body><notificationsResponse xmlns="` + Namespace + `"><Ack>true</Ack></notificationsResponse></soapenv:Body>` +
+		`</soapenv:Envelope>`))
+
+}
+
+/*
+ *	writeFault
+ *	Responds with a SOAP fault, so that Salesforce retries the message.
+ *	@since	2.0.0
+ */
+func writeFault(w http.ResponseWriter, statusCode int, message string) {
+
+	var faultString strings.Builder
+	xml.EscapeText(&faultString, []byte(message))
+
+	w.Header().Set("Content-Type", "text/xml; charset=UTF-8")
+	w.WriteHeader(statusCode)
+	w.Write([]byte(xml.Header + `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">` +
+		`<soapenv:Body><soapenv:Fault><faultcode>soapenv:Client</faultcode><faultstring>` + faultString.String() + `</faultstring></soapenv:Fault></soapenv:Body>` +
+		`</soapenv:Envelope>`))
+
+}
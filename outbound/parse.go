@@ -0,0 +1,112 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package outbound
+
+// Import standard packages.
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+/*
+ *	envelope
+ *	The SOAP envelope of an Outbound Message.
+ *	@since	2.0.0
+ */
+type envelope struct {
+	Body struct {
+		Notifications *struct {
+			OrganizationId string         `xml:"OrganizationId"`
+			ActionId       string         `xml:"ActionId"`
+			SessionId      string         `xml:"SessionId"`
+			EnterpriseUrl  string         `xml:"EnterpriseUrl"`
+			PartnerUrl     string         `xml:"PartnerUrl"`
+			Notification   []notification `xml:"Notification"`
+		} `xml:"http://soap.sforce.com/2005/09/outbound notifications"`
+	} `xml:"Body"`
+}
+
+/*
+ *	notification
+ *	@since	2.0.0
+ */
+type notification struct {
+	Id      string `xml:"Id"`
+	SObject struct {
+		Type   string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+		Fields []struct {
+			XMLName xml.Name
+			Nil     bool   `xml:"http://www.w3.org/2001/XMLSchema-instance nil,attr"`
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	} `xml:"sObject"`
+}
+
+/*
+ *	Parse
+ *	Parses the SOAP envelope of an Outbound Message, without validating its org ID.
+ *	@since	2.0.0
+ */
+func Parse(r io.Reader) (*Message, error) {
+
+	var e envelope
+	if err := xml.NewDecoder(r).Decode(&e); err != nil {
+		return nil, err
+	}
+
+	n := e.Body.Notifications
+	if n == nil {
+		return nil, errors.New("outbound: not an outbound message")
+	}
+
+	message := &Message{
+		OrganizationId: n.OrganizationId,
+		ActionId:       n.ActionId,
+		SessionId:      n.SessionId,
+		EnterpriseUrl:  n.EnterpriseUrl,
+		PartnerUrl:     n.PartnerUrl,
+		Notifications:  make([]Notification, 0, len(n.Notification)),
+	}
+
+	for _, item := range n.Notification {
+		// The type is qualified by the prefix of the sObject namespace, e.g. sf:Account.
+		_, objectType, _ := strings.Cut(item.SObject.Type, ":")
+		if objectType == "" {
+			objectType = item.SObject.Type
+		}
+
+		notification := Notification{
+			Id:     item.Id,
+			Type:   objectType,
+			Fields: make(map[string]string, len(item.SObject.Fields)),
+		}
+
+		for _, f := range item.SObject.Fields {
+			if !f.Nil {
+				notification.Fields[f.XMLName.Local] = f.Value
+			}
+		}
+
+		message.Notifications = append(message.Notifications, notification)
+	}
+
+	return message, nil
+
+}
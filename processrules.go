@@ -0,0 +1,93 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	ProcessRule
+ *	An active workflow rule of an object.
+ *	@since	2.0.0
+ */
+type ProcessRule struct {
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	NamespacePrefix string `json:"namespacePrefix"`
+	Object          string `json:"object"`
+
+	Actions []struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+
+		// FieldUpdate, EmailAlert, Task, OutboundMessage, ...
+		Type string `json:"type"`
+	} `json:"actions"`
+}
+
+/*
+ *	ProcessRules
+ *	Returns the active workflow rules of an object, or of all objects, by object, if object is empty.
+ *	@since	2.0.0
+ */
+func (c *Client) ProcessRules(ctx context.Context, object string) (map[string][]ProcessRule, error) {
+
+	path := "process/rules/"
+	if object != "" {
+		path += object + "/"
+	}
+
+	var list struct {
+		Rules map[string][]ProcessRule `json:"rules"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, path), nil, &list); err != nil {
+		return nil, err
+	}
+
+	return list.Rules, nil
+
+}
+
+/*
+ *	TriggerProcessRules
+ *	Evaluates the workflow rules of up to 200 records, as if they had been saved, and runs the actions of the rules they meet.
+ *	@since	2.0.0
+ */
+func (c *Client) TriggerProcessRules(ctx context.Context, ids ...string) error {
+
+	var result struct {
+		Success bool              `json:"success"`
+		Errors  []SalesforceError `json:"errors"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "process/rules/"), map[string]interface{}{"contextIds": ids}, &result); err != nil {
+		return err
+	}
+
+	if result.Success {
+		return nil
+	}
+
+	return joinErrors(0, result.Errors)
+
+}
@@ -0,0 +1,602 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+/*
+ *	schema
+ *	A parsed Avro schema.
+ *	@since	2.0.0
+ */
+type schema struct {
+	// null, boolean, int, long, float, double, bytes, string, record, enum, array, map, union, or fixed.
+	Type string
+
+	// Full name of named types.
+	Name string
+
+	Fields   []schemaField
+	Symbols  []string
+	Items    *schema
+	Values   *schema
+	Branches []*schema
+	Size     int
+}
+
+/*
+ *	schemaField
+ *	A field of an Avro record.
+ *	@since	2.0.0
+ */
+type schemaField struct {
+	Name    string
+	Type    *schema
+	Default interface{}
+
+	// Whether the field has a default value, which may be null.
+	HasDefault bool
+}
+
+/*
+ *	parseSchema
+ *	Parses an Avro schema given as JSON, e.g. SchemaInfo.SchemaJson.
+ *	@since	2.0.0
+ */
+func parseSchema(schemaJson string) (*schema, error) {
+
+	var definition interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(schemaJson))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&definition); err != nil {
+		return nil, err
+	}
+
+	return (&schemaParser{names: make(map[string]*schema)}).parse(definition, "")
+
+}
+
+/*
+ *	schemaParser
+ *	Resolves references to named types while parsing a schema.
+ *	@since	2.0.0
+ */
+type schemaParser struct {
+	names map[string]*schema
+}
+
+/*
+ *	parse
+ *	Parses a schema definition within the given namespace.
+ *	@since	2.0.0
+ */
+func (p *schemaParser) parse(definition interface{}, namespace string) (*schema, error) {
+
+	switch definition := definition.(type) {
+	case string:
+		return p.resolve(definition, namespace)
+	case []interface{}:
+		union := &schema{Type: "union"}
+		for _, branch := range definition {
+			parsed, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.Branches = append(union.Branches, parsed)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return p.parseComplex(definition, namespace)
+	}
+
+	return nil, fmt.Errorf("pubsub: invalid avro schema: %v", definition)
+
+}
+
+/*
+ *	resolve
+ *	Returns a primitive type, or the named type with the given name.
+ *	@since	2.0.0
+ */
+func (p *schemaParser) resolve(name string, namespace string) (*schema, error) {
+
+	switch name {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		return &schema{Type: name}, nil
+	}
+
+	if named, ok := p.names[fullName(name, namespace)]; ok {
+		return named, nil
+	}
+
+	if named, ok := p.names[name]; ok {
+		return named, nil
+	}
+
+	return nil, errors.New("pubsub: unknown avro type " + name)
+
+}
+
+/*
+ *	parseComplex
+ *	Parses a schema given as a JSON object.
+ *	@since	2.0.0
+ */
+func (p *schemaParser) parseComplex(definition map[string]interface{}, namespace string) (*schema, error) {
+
+	typeName, _ := definition["type"].(string)
+
+	switch typeName {
+	case "record", "error", "enum", "fixed":
+		name, _ := definition["name"].(string)
+		if ns, ok := definition["namespace"].(string); ok {
+			namespace = ns
+		}
+
+		named := &schema{Type: typeName, Name: fullName(name, namespace)}
+		if typeName == "error" {
+			named.Type = "record"
+		}
+
+		// Named types are registered before their fields, which may refer to them.
+		p.names[named.Name] = named
+
+		// Names within the type default to its namespace.
+		if i := strings.LastIndex(named.Name, "."); i >= 0 {
+			namespace = named.Name[:i]
+		}
+
+		switch named.Type {
+		case "record":
+			fields, _ := definition["fields"].([]interface{})
+			for _, f := range fields {
+				f, _ := f.(map[string]interface{})
+				fieldType, err := p.parse(f["type"], namespace)
+				if err != nil {
+					return nil, err
+				}
+				field := schemaField{Type: fieldType}
+				field.Name, _ = f["name"].(string)
+				field.Default, field.HasDefault = f["default"]
+				named.Fields = append(named.Fields, field)
+			}
+		case "enum":
+			symbols, _ := definition["symbols"].([]interface{})
+			for _, symbol := range symbols {
+				s, _ := symbol.(string)
+				named.Symbols = append(named.Symbols, s)
+			}
+		case "fixed":
+			size, _ := definition["size"].(json.Number).Int64()
+			named.Size = int(size)
+		}
+
+		return named, nil
+	case "array":
+		items, err := p.parse(definition["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &schema{Type: "array", Items: items}, nil
+	case "map":
+		values, err := p.parse(definition["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &schema{Type: "map", Values: values}, nil
+	}
+
+	// A primitive type, possibly with a logical type, e.g. {"type": "long", "logicalType": "timestamp-millis"}.
+	return p.parse(definition["type"], namespace)
+
+}
+
+/*
+ *	fullName
+ *	Returns the full name of a named type.
+ *	@since	2.0.0
+ */
+func fullName(name string, namespace string) string {
+
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+
+	return namespace + "." + name
+
+}
+
+/*
+ *	encode
+ *	Appends the Avro binary encoding of a value.
+ *	Records are given as map[string]interface{}; missing fields take their default, or else their zero value.
+ *	@since	2.0.0
+ */
+func (s *schema) encode(b []byte, value interface{}) ([]byte, error) {
+
+	switch s.Type {
+	case "null":
+		return b, nil
+	case "boolean":
+		v, _ := value.(bool)
+		if v {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case "int", "long":
+		v, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		return binary.AppendVarint(b, v), nil
+	case "float":
+		v, err := toFloat64(value)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(v))), nil
+	case "double":
+		v, err := toFloat64(value)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(v)), nil
+	case "bytes", "string", "fixed":
+		var data []byte
+		switch v := value.(type) {
+		case string:
+			data = []byte(v)
+		case []byte:
+			data = v
+		case nil:
+		default:
+			data = []byte(fmt.Sprint(v))
+		}
+		if s.Type == "fixed" {
+			if len(data) != s.Size {
+				return nil, fmt.Errorf("pubsub: %s needs %d bytes", s.Name, s.Size)
+			}
+			return append(b, data...), nil
+		}
+		b = binary.AppendVarint(b, int64(len(data)))
+		return append(b, data...), nil
+	case "enum":
+		symbol, _ := value.(string)
+		for i, s := range s.Symbols {
+			if s == symbol {
+				return binary.AppendVarint(b, int64(i)), nil
+			}
+		}
+		if value == nil && len(s.Symbols) > 0 {
+			return binary.AppendVarint(b, 0), nil
+		}
+		return nil, fmt.Errorf("pubsub: %q is not a symbol of %s", symbol, s.Name)
+	case "array":
+		items, _ := value.([]interface{})
+		if len(items) > 0 {
+			b = binary.AppendVarint(b, int64(len(items)))
+			for _, item := range items {
+				var err error
+				if b, err = s.Items.encode(b, item); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return append(b, 0), nil
+	case "map":
+		entries, _ := value.(map[string]interface{})
+		if len(entries) > 0 {
+			b = binary.AppendVarint(b, int64(len(entries)))
+			for key, entry := range entries {
+				b = binary.AppendVarint(b, int64(len(key)))
+				b = append(b, key...)
+				var err error
+				if b, err = s.Values.encode(b, entry); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return append(b, 0), nil
+	case "union":
+		for i, branch := range s.Branches {
+			if branch.accepts(value) {
+				b = binary.AppendVarint(b, int64(i))
+				return branch.encode(b, value)
+			}
+		}
+		return nil, fmt.Errorf("pubsub: %v matches no branch of the union", value)
+	case "record":
+		fields, _ := value.(map[string]interface{})
+		for _, field := range s.Fields {
+			v, ok := fields[field.Name]
+			if !ok {
+				v = field.defaultValue()
+			}
+			var err error
+			if b, err = field.Type.encode(b, v); err != nil {
+				return nil, fmt.Errorf("pubsub: field %s: %w", field.Name, err)
+			}
+		}
+		return b, nil
+	}
+
+	return nil, errors.New("pubsub: unsupported avro type " + s.Type)
+
+}
+
+/*
+ *	defaultValue
+ *	Returns the value of a field missing from a record.
+ *	@since	2.0.0
+ */
+func (f *schemaField) defaultValue() interface{} {
+
+	if f.HasDefault {
+		return f.Default
+	}
+
+	// Salesforce sets CreatedDate and CreatedById of published events.
+	switch f.Type.Type {
+	case "long", "int", "float", "double":
+		return 0
+	case "string", "bytes":
+		return ""
+	}
+
+	return nil
+
+}
+
+/*
+ *	accepts
+ *	Reports whether a value can be encoded with the schema, to select the branch of a union.
+ *	@since	2.0.0
+ */
+func (s *schema) accepts(value interface{}) bool {
+
+	switch s.Type {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "int", "long", "float", "double":
+		_, err := toFloat64(value)
+		return value != nil && err == nil
+	case "string", "enum":
+		_, ok := value.(string)
+		return ok
+	case "bytes", "fixed":
+		_, ok := value.([]byte)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "map", "record":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+
+	return false
+
+}
+
+/*
+ *	toInt64
+ *	Converts a number, or a time.Time to milliseconds since the epoch.
+ *	@since	2.0.0
+ */
+func toInt64(value interface{}) (int64, error) {
+
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	case json.Number:
+		return v.Int64()
+	case time.Time:
+		return v.UnixMilli(), nil
+	case nil:
+		return 0, nil
+	}
+
+	return 0, fmt.Errorf("pubsub: %v is not an integer", value)
+
+}
+
+/*
+ *	toFloat64
+ *	Converts a number, or a time.Time to milliseconds since the epoch.
+ *	@since	2.0.0
+ */
+func toFloat64(value interface{}) (float64, error) {
+
+	switch v := value.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	}
+
+	i, err := toInt64(value)
+
+	return float64(i), err
+
+}
+
+/*
+ *	decode
+ *	Decodes a value from its Avro binary encoding, and returns it with the remaining bytes.
+ *	Records and maps are decoded as map[string]interface{}, arrays as []interface{}, ints and longs as int64,
+ *	floats and doubles as float64, and enums as their symbol.
+ *	@since	2.0.0
+ */
+func (s *schema) decode(b []byte) (interface{}, []byte, error) {
+
+	switch s.Type {
+	case "null":
+		return nil, b, nil
+	case "boolean":
+		if len(b) < 1 {
+			return nil, nil, errMalformed
+		}
+		return b[0] != 0, b[1:], nil
+	case "int", "long":
+		v, n := binary.Varint(b)
+		if n <= 0 {
+			return nil, nil, errMalformed
+		}
+		return v, b[n:], nil
+	case "float":
+		if len(b) < 4 {
+			return nil, nil, errMalformed
+		}
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), b[4:], nil
+	case "double":
+		if len(b) < 8 {
+			return nil, nil, errMalformed
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), b[8:], nil
+	case "bytes", "string":
+		length, n := binary.Varint(b)
+		if n <= 0 || length < 0 || int64(len(b)-n) < length {
+			return nil, nil, errMalformed
+		}
+		data := b[n : n+int(length)]
+		if s.Type == "string" {
+			return string(data), b[n+int(length):], nil
+		}
+		return data, b[n+int(length):], nil
+	case "fixed":
+		if len(b) < s.Size {
+			return nil, nil, errMalformed
+		}
+		return b[:s.Size], b[s.Size:], nil
+	case "enum":
+		i, n := binary.Varint(b)
+		if n <= 0 || i < 0 || int(i) >= len(s.Symbols) {
+			return nil, nil, errMalformed
+		}
+		return s.Symbols[i], b[n:], nil
+	case "array", "map":
+		var items []interface{}
+		entries := make(map[string]interface{})
+		for {
+			count, n := binary.Varint(b)
+			if n <= 0 {
+				return nil, nil, errMalformed
+			}
+			b = b[n:]
+			if count == 0 {
+				break
+			}
+			// Negative counts are followed by the size of the block in bytes.
+			if count < 0 {
+				count = -count
+				if _, n = binary.Varint(b); n <= 0 {
+					return nil, nil, errMalformed
+				}
+				b = b[n:]
+			}
+			for ; count > 0; count-- {
+				var item interface{}
+				var err error
+				if s.Type == "array" {
+					if item, b, err = s.Items.decode(b); err != nil {
+						return nil, nil, err
+					}
+					items = append(items, item)
+					continue
+				}
+				var key interface{}
+				if key, b, err = (&schema{Type: "string"}).decode(b); err != nil {
+					return nil, nil, err
+				}
+				if item, b, err = s.Values.decode(b); err != nil {
+					return nil, nil, err
+				}
+				entries[key.(string)] = item
+			}
+		}
+		if s.Type == "array" {
+			return items, b, nil
+		}
+		return entries, b, nil
+	case "union":
+		i, n := binary.Varint(b)
+		if n <= 0 || i < 0 || int(i) >= len(s.Branches) {
+			return nil, nil, errMalformed
+		}
+		return s.Branches[i].decode(b[n:])
+	case "record":
+		fields := make(map[string]interface{}, len(s.Fields))
+		for _, field := range s.Fields {
+			value, rest, err := field.Type.decode(b)
+			if err != nil {
+				return nil, nil, fmt.Errorf("pubsub: field %s: %w", field.Name, err)
+			}
+			fields[field.Name] = value
+			b = rest
+		}
+		return fields, b, nil
+	}
+
+	return nil, nil, errors.New("pubsub: unsupported avro type " + s.Type)
+
+}
+
+/*
+ *	record
+ *	Returns the record schema of a field, unwrapping a union with null.
+ *	@since	2.0.0
+ */
+func (s *schema) record() *schema {
+
+	if s.Type == "union" {
+		for _, branch := range s.Branches {
+			if branch.Type == "record" {
+				return branch
+			}
+		}
+	}
+
+	if s.Type == "record" {
+		return s
+	}
+
+	return nil
+
+}
@@ -0,0 +1,355 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+/*
+ *	mustParseSchema
+ *	Parses a schema, failing the test if it is invalid.
+ *	@since	2.0.0
+ */
+func mustParseSchema(t *testing.T, schemaJson string) *schema {
+
+	t.Helper()
+
+	parsed, err := parseSchema(schemaJson)
+	if err != nil {
+		t.Fatalf("parseSchema: %v", err)
+	}
+
+	return parsed
+
+}
+
+/*
+ *	TestAvroLongEncoding
+ *	Longs are written as zig-zag varints.
+ *	@since	2.0.0
+ */
+func TestAvroLongEncoding(t *testing.T) {
+
+	long := mustParseSchema(t, `"long"`)
+
+	tests := []struct {
+		value int64
+		want  []byte
+	}{
+		{0, []byte{0x00}},
+		{-1, []byte{0x01}},
+		{1, []byte{0x02}},
+		{-2, []byte{0x03}},
+		{63, []byte{0x7e}},
+		{-64, []byte{0x7f}},
+		{64, []byte{0x80, 0x01}},
+		{math.MaxInt64, []byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{math.MinInt64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+
+	for _, test := range tests {
+		got, err := long.encode(nil, test.value)
+		if err != nil {
+			t.Fatalf("encode(%d): %v", test.value, err)
+		}
+
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("encode(%d) = % x, want % x", test.value, got, test.want)
+		}
+
+		decoded, rest, err := long.decode(got)
+		if err != nil || decoded != test.value || len(rest) != 0 {
+			t.Errorf("decode(% x) = %v, % x, %v, want %d", got, decoded, rest, err, test.value)
+		}
+	}
+
+}
+
+/*
+ *	TestAvroUnionEncoding
+ *	Unions are written as the index of the branch, then the value.
+ *	@since	2.0.0
+ */
+func TestAvroUnionEncoding(t *testing.T) {
+
+	union := mustParseSchema(t, `["null", "string", "long"]`)
+
+	tests := []struct {
+		value interface{}
+		want  []byte
+	}{
+		{nil, []byte{0x00}},
+		{"a", []byte{0x02, 0x02, 'a'}},
+		{"", []byte{0x02, 0x00}},
+		{int64(-3), []byte{0x04, 0x05}},
+	}
+
+	for _, test := range tests {
+		got, err := union.encode(nil, test.value)
+		if err != nil {
+			t.Fatalf("encode(%v): %v", test.value, err)
+		}
+
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("encode(%v) = % x, want % x", test.value, got, test.want)
+		}
+
+		decoded, _, err := union.decode(got)
+		if err != nil || decoded != test.value {
+			t.Errorf("decode(% x) = %v, %v, want %v", got, decoded, err, test.value)
+		}
+	}
+
+	if _, err := union.encode(nil, true); err == nil {
+		t.Error("encode(true) succeeded, want an error")
+	}
+
+}
+
+/*
+ *	TestAvroRecordRoundTrip
+ *	@since	2.0.0
+ */
+func TestAvroRecordRoundTrip(t *testing.T) {
+
+	record := mustParseSchema(t, `{
+		"type": "record",
+		"name": "Order_Placed__e",
+		"namespace": "com.sforce.eventbus",
+		"fields": [
+			{"name": "CreatedDate", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+			{"name": "Quantity__c", "type": ["null", "int"], "default": null},
+			{"name": "Amount__c", "type": ["null", "double"], "default": null},
+			{"name": "Ratio", "type": "float"},
+			{"name": "Paid__c", "type": "boolean"},
+			{"name": "Name__c", "type": ["null", "string"], "default": null},
+			{"name": "Data", "type": "bytes"},
+			{"name": "Status", "type": {"type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"]}},
+			{"name": "Hash", "type": {"type": "fixed", "name": "Hash", "size": 2}},
+			{"name": "Tags", "type": {"type": "array", "items": "string"}},
+			{"name": "Counts", "type": {"type": "map", "values": "long"}},
+			{"name": "Address", "type": ["null", {
+				"type": "record",
+				"name": "Address",
+				"fields": [
+					{"name": "City", "type": ["null", "string"], "default": null},
+					{"name": "Status", "type": "Status"}
+				]
+			}], "default": null},
+			{"name": "Previous", "type": ["null", "Address"], "default": null}
+		]
+	}`)
+
+	value := map[string]interface{}{
+		"CreatedDate": int64(1704164645000),
+		"Quantity__c": int64(-5),
+		"Amount__c":   nil,
+		"Ratio":       0.5,
+		"Paid__c":     true,
+		"Name__c":     "Ünïcode ✓",
+		"Data":        []byte{0, 255},
+		"Status":      "CLOSED",
+		"Hash":        []byte{1, 2},
+		"Tags":        []interface{}{"a", "", "c"},
+		"Counts":      map[string]interface{}{"x": int64(1), "y": int64(-1)},
+		"Address": map[string]interface{}{
+			"City":   "Paris",
+			"Status": "OPEN",
+		},
+		"Previous": nil,
+	}
+
+	encoded, err := record.encode(nil, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, rest, err := record.decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rest) != 0 {
+		t.Errorf("%d bytes left after decoding", len(rest))
+	}
+
+	if !reflect.DeepEqual(decoded, value) {
+		t.Errorf("decoded %#v, want %#v", decoded, value)
+	}
+
+}
+
+/*
+ *	TestAvroRecordDefaults
+ *	Fields missing from a record take their default, or else their zero value.
+ *	@since	2.0.0
+ */
+func TestAvroRecordDefaults(t *testing.T) {
+
+	record := mustParseSchema(t, `{
+		"type": "record",
+		"name": "Event",
+		"fields": [
+			{"name": "CreatedById", "type": "string"},
+			{"name": "CreatedDate", "type": "long"},
+			{"name": "Priority", "type": "int", "default": 3},
+			{"name": "Note", "type": ["null", "string"], "default": null}
+		]
+	}`)
+
+	encoded, err := record.encode(nil, map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, _, err := record.decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"CreatedById": "", "CreatedDate": int64(0), "Priority": int64(3), "Note": nil}
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("decoded %#v, want %#v", decoded, want)
+	}
+
+}
+
+/*
+ *	TestAvroDecodeBlocks
+ *	Arrays may be written in several blocks, with negative counts followed by the size of the block.
+ *	@since	2.0.0
+ */
+func TestAvroDecodeBlocks(t *testing.T) {
+
+	array := mustParseSchema(t, `{"type": "array", "items": "long"}`)
+
+	// A block of 2 items, a block of -1 item of 1 byte, then the end.
+	b := []byte{0x04, 0x02, 0x04, 0x01, 0x02, 0x06, 0x00}
+
+	decoded, rest, err := array.decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []interface{}{int64(1), int64(2), int64(3)}; !reflect.DeepEqual(decoded, want) || len(rest) != 0 {
+		t.Errorf("decoded %v with % x left, want %v", decoded, rest, want)
+	}
+
+}
+
+/*
+ *	TestAvroDecodeMalformed
+ *	@since	2.0.0
+ */
+func TestAvroDecodeMalformed(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		schema string
+		b      []byte
+	}{
+		{"truncated long", `"long"`, []byte{0x80}},
+		{"empty boolean", `"boolean"`, nil},
+		{"truncated double", `"double"`, []byte{1, 2, 3}},
+		{"string past end", `"string"`, []byte{0x06, 'a'}},
+		{"negative length", `"bytes"`, []byte{0x01}},
+		{"enum out of range", `{"type": "enum", "name": "E", "symbols": ["A"]}`, []byte{0x02}},
+		{"union out of range", `["null", "string"]`, []byte{0x04}},
+		{"negative union", `["null", "string"]`, []byte{0x01}},
+		{"truncated fixed", `{"type": "fixed", "name": "F", "size": 4}`, []byte{1}},
+		{"unterminated array", `{"type": "array", "items": "long"}`, []byte{0x02, 0x02}},
+	}
+
+	for _, test := range tests {
+		if _, _, err := mustParseSchema(t, test.schema).decode(test.b); err == nil {
+			t.Errorf("%s: decoding succeeded, want an error", test.name)
+		}
+	}
+
+}
+
+/*
+ *	TestExpandBitmaps
+ *	@since	2.0.0
+ */
+func TestExpandBitmaps(t *testing.T) {
+
+	event := mustParseSchema(t, `{
+		"type": "record",
+		"name": "AccountChangeEvent",
+		"fields": [
+			{"name": "ChangeEventHeader", "type": "string"},
+			{"name": "Name", "type": ["null", "string"], "default": null},
+			{"name": "BillingAddress", "type": ["null", {
+				"type": "record",
+				"name": "Address",
+				"fields": [
+					{"name": "Street", "type": ["null", "string"]},
+					{"name": "City", "type": ["null", "string"]},
+					{"name": "PostalCode", "type": ["null", "string"]}
+				]
+			}], "default": null},
+			{"name": "Phone", "type": ["null", "string"], "default": null},
+			{"name": "F4", "type": "string"}, {"name": "F5", "type": "string"}, {"name": "F6", "type": "string"},
+			{"name": "F7", "type": "string"}, {"name": "F8", "type": "string"}, {"name": "F9", "type": "string"},
+			{"name": "F10", "type": "string"}, {"name": "F11", "type": "string"}, {"name": "F12", "type": "string"},
+			{"name": "F13", "type": "string"}, {"name": "F14", "type": "string"}, {"name": "F15", "type": "string"},
+			{"name": "F16", "type": "string"}
+		]
+	}`)
+
+	tests := []struct {
+		name    string
+		bitmaps []string
+		want    []string
+		invalid bool
+	}{
+		{"none", nil, nil, false},
+		{"top-level", []string{"0xA"}, []string{"Name", "Phone"}, false},
+		{"beyond 64 bits", []string{"0x10000000000000000"}, nil, false},
+		{"past 8 fields", []string{"0x10002"}, []string{"Name", "F16"}, false},
+		{"compound", []string{"2-0x5"}, []string{"BillingAddress.Street", "BillingAddress.PostalCode"}, false},
+		{"mixed", []string{"0x2", "2-0x2"}, []string{"Name", "BillingAddress.City"}, false},
+		{"not hexadecimal", []string{"0xZZ"}, nil, true},
+		{"position out of range", []string{"17-0x1"}, nil, true},
+		{"not compound", []string{"1-0x1"}, nil, true},
+	}
+
+	for _, test := range tests {
+		got, err := expandBitmaps(event, test.bitmaps)
+
+		if test.invalid {
+			if err == nil {
+				t.Errorf("%s: expanded %v, want an error", test.name, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		} else if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expanded %v, want %v", test.name, got, test.want)
+		}
+	}
+
+}
@@ -0,0 +1,250 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+/*
+ *	Decode
+ *	Decodes the Avro payload of an event into its field values, using the schema of the event.
+ *	Null fields are present with a nil value.
+ *	@since	2.0.0
+ */
+func (p *Client) Decode(ctx context.Context, event ProducerEvent) (map[string]interface{}, error) {
+
+	schema, err := p.schema(ctx, event.SchemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _, err := schema.decode(event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("pubsub: payload is not a record")
+	}
+
+	return fields, nil
+
+}
+
+/*
+ *	DecodeInto
+ *	Decodes the Avro payload of an event into v, which must be a pointer, matching fields by their json tags.
+ *	@since	2.0.0
+ */
+func (p *Client) DecodeInto(ctx context.Context, event ProducerEvent, v interface{}) error {
+
+	fields, err := p.Decode(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+
+}
+
+/*
+ *	ChangeEventHeader
+ *	The header of a change event, with the changed, nulled, and diff fields expanded to field names.
+ *	Fields of compound fields are named after them, e.g. Name.LastName.
+ *	@since	2.0.0
+ */
+type ChangeEventHeader struct {
+	EntityName      string   `json:"entityName"`
+	RecordIds       []string `json:"recordIds"`
+	ChangeType      string   `json:"changeType"`
+	ChangeOrigin    string   `json:"changeOrigin"`
+	TransactionKey  string   `json:"transactionKey"`
+	SequenceNumber  int      `json:"sequenceNumber"`
+	CommitTimestamp int64    `json:"commitTimestamp"`
+	CommitNumber    int64    `json:"commitNumber"`
+	CommitUser      string   `json:"commitUser"`
+	NulledFields    []string `json:"nulledFields"`
+	DiffFields      []string `json:"diffFields"`
+	ChangedFields   []string `json:"changedFields"`
+}
+
+/*
+ *	ChangeEvent
+ *	A decoded Change Data Capture event.
+ *	@since	2.0.0
+ */
+type ChangeEvent struct {
+	Header ChangeEventHeader
+
+	// Values of the fields of the event, except the header. Unchanged fields are nil.
+	Fields map[string]interface{}
+}
+
+/*
+ *	Changes
+ *	Returns the values of the fields changed by the event, keyed by field name, with nil for nulled fields.
+ *	For events creating records, every field set is returned.
+ *	@since	2.0.0
+ */
+func (e *ChangeEvent) Changes() map[string]interface{} {
+
+	changes := make(map[string]interface{})
+
+	if e.Header.ChangeType == "CREATE" || e.Header.ChangeType == "GAP_CREATE" {
+		for name, value := range e.Fields {
+			if value != nil {
+				changes[name] = value
+			}
+		}
+		return changes
+	}
+
+	for _, name := range e.Header.ChangedFields {
+		changes[name] = lookup(e.Fields, name)
+	}
+
+	for _, name := range e.Header.NulledFields {
+		changes[name] = nil
+	}
+
+	return changes
+
+}
+
+/*
+ *	lookup
+ *	Returns the value of a field, following the name of a compound field, e.g. Name.LastName.
+ *	@since	2.0.0
+ */
+func lookup(fields map[string]interface{}, name string) interface{} {
+
+	name, rest, nested := strings.Cut(name, ".")
+
+	if !nested {
+		return fields[name]
+	}
+
+	compound, _ := fields[name].(map[string]interface{})
+
+	return lookup(compound, rest)
+
+}
+
+/*
+ *	DecodeChangeEvent
+ *	Decodes the Avro payload of a Change Data Capture event, expanding the bitmaps of
+ *	changed, nulled, and diff fields in its header to field names.
+ *	@since	2.0.0
+ */
+func (p *Client) DecodeChangeEvent(ctx context.Context, event ProducerEvent) (*ChangeEvent, error) {
+
+	schema, err := p.schema(ctx, event.SchemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _, err := schema.decode(event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("pubsub: payload is not a record")
+	}
+
+	header, ok := fields["ChangeEventHeader"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("pubsub: not a change event")
+	}
+
+	delete(fields, "ChangeEventHeader")
+
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	changeEvent := &ChangeEvent{Fields: fields}
+
+	if err := json.Unmarshal(data, &changeEvent.Header); err != nil {
+		return nil, err
+	}
+
+	for _, list := range []*[]string{&changeEvent.Header.ChangedFields, &changeEvent.Header.NulledFields, &changeEvent.Header.DiffFields} {
+		if *list, err = expandBitmaps(schema, *list); err != nil {
+			return nil, err
+		}
+	}
+
+	return changeEvent, nil
+
+}
+
+/*
+ *	expandBitmaps
+ *	Expands field bitmaps to field names. A bitmap, e.g. 0x5, selects the fields of the event at the positions of its set bits;
+ *	a bitmap prefixed with a position, e.g. 3-0x2, selects the fields of the compound field at that position.
+ *	@since	2.0.0
+ */
+func expandBitmaps(schema *schema, bitmaps []string) ([]string, error) {
+
+	var names []string
+
+	for _, bitmap := range bitmaps {
+		record, prefix := schema, ""
+
+		if position, rest, ok := strings.Cut(bitmap, "-"); ok {
+			i, err := strconv.Atoi(position)
+			if err != nil || i < 0 || i >= len(schema.Fields) {
+				return nil, errors.New("pubsub: invalid field bitmap " + bitmap)
+			}
+			if record = schema.Fields[i].Type.record(); record == nil {
+				return nil, errors.New("pubsub: field bitmap " + bitmap + " selects no compound field")
+			}
+			prefix, bitmap = schema.Fields[i].Name+".", rest
+		}
+
+		bits, ok := new(big.Int).SetString(strings.TrimPrefix(bitmap, "0x"), 16)
+		if !ok {
+			return nil, errors.New("pubsub: invalid field bitmap " + bitmap)
+		}
+
+		for i := 0; i < bits.BitLen() && i < len(record.Fields); i++ {
+			if bits.Bit(i) == 1 {
+				names = append(names, prefix+record.Fields[i].Name)
+			}
+		}
+	}
+
+	return names, nil
+
+}
@@ -0,0 +1,236 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+)
+
+/*
+ *	EventHeader
+ *	A header of an event.
+ *	@since	2.0.0
+ */
+type EventHeader struct {
+	Key   string
+	Value []byte
+}
+
+/*
+ *	ProducerEvent
+ *	An event: its Avro-encoded payload and the ID of the schema used to encode it.
+ *	@since	2.0.0
+ */
+type ProducerEvent struct {
+	Id       string
+	SchemaId string
+	Payload  []byte
+	Headers  []EventHeader
+}
+
+/*
+ *	encode
+ *	@since	2.0.0
+ */
+func (e *ProducerEvent) encode() []byte {
+
+	var b []byte
+
+	b = appendString(b, 1, e.Id)
+	b = appendString(b, 2, e.SchemaId)
+	b = appendBytes(b, 3, e.Payload)
+
+	for _, header := range e.Headers {
+		var h []byte
+		h = appendString(h, 1, header.Key)
+		h = appendBytes(h, 2, header.Value)
+		b = appendMessage(b, 4, h)
+	}
+
+	return b
+
+}
+
+/*
+ *	decodeProducerEvent
+ *	@since	2.0.0
+ */
+func decodeProducerEvent(b []byte) (ProducerEvent, error) {
+
+	var event ProducerEvent
+
+	err := decodeFields(b, func(f field) error {
+		switch f.number {
+		case 1:
+			event.Id = string(f.bytes)
+		case 2:
+			event.SchemaId = string(f.bytes)
+		case 3:
+			event.Payload = f.bytes
+		case 4:
+			var header EventHeader
+			err := decodeFields(f.bytes, func(f field) error {
+				switch f.number {
+				case 1:
+					header.Key = string(f.bytes)
+				case 2:
+					header.Value = f.bytes
+				}
+				return nil
+			})
+			event.Headers = append(event.Headers, header)
+			return err
+		}
+		return nil
+	})
+
+	return event, err
+
+}
+
+/*
+ *	ConsumerEvent
+ *	An event received from a subscription, and its replay ID, used to resume the subscription after it.
+ *	@since	2.0.0
+ */
+type ConsumerEvent struct {
+	Event    ProducerEvent
+	ReplayId []byte
+}
+
+/*
+ *	PublishResult
+ *	The result of publishing a single event. Error is nil if the event was published.
+ *	@since	2.0.0
+ */
+type PublishResult struct {
+	ReplayId       []byte
+	Error          error
+	CorrelationKey string
+}
+
+/*
+ *	Publish
+ *	Publishes events, encoded with the schema of the topic, to a topic, e.g. /event/Order_Placed__e.
+ *	The results are returned in the order of the events.
+ *	@since	2.0.0
+ */
+func (p *Client) Publish(ctx context.Context, topicName string, events ...ProducerEvent) ([]PublishResult, error) {
+
+	var request []byte
+
+	request = appendString(request, 1, topicName)
+	for i := range events {
+		request = appendMessage(request, 2, events[i].encode())
+	}
+
+	reply, err := p.invoke(ctx, "Publish", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []PublishResult
+
+	err = decodeFields(reply, func(f field) error {
+		if f.number != 1 {
+			return nil
+		}
+
+		var result PublishResult
+
+		err := decodeFields(f.bytes, func(f field) error {
+			switch f.number {
+			case 1:
+				result.ReplayId = f.bytes
+			case 2:
+				var code uint64
+				var message string
+				decodeFields(f.bytes, func(f field) error {
+					switch f.number {
+					case 1:
+						code = f.varint
+					case 2:
+						message = string(f.bytes)
+					}
+					return nil
+				})
+				result.Error = errors.New("pubsub: publish error " + publishErrorCodes[code] + ": " + message)
+			case 3:
+				result.CorrelationKey = string(f.bytes)
+			}
+			return nil
+		})
+
+		results = append(results, result)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+
+}
+
+/*
+ *	PublishEvents
+ *	Publishes events given as field values, e.g. {"Order_Number__c": "1042"}, to a topic, e.g. /event/Order_Placed__e,
+ *	encoding them with the schema of the topic. Fields left out take their default value, usually null.
+ *	The results are returned in the order of the events.
+ *	@since	2.0.0
+ */
+func (p *Client) PublishEvents(ctx context.Context, topicName string, payloads ...map[string]interface{}) ([]PublishResult, error) {
+
+	topic, err := p.GetTopic(ctx, topicName)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := p.schema(ctx, topic.SchemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ProducerEvent, len(payloads))
+
+	for i, payload := range payloads {
+		encoded, err := schema.encode(nil, payload)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = ProducerEvent{SchemaId: topic.SchemaId, Payload: encoded}
+	}
+
+	return p.Publish(ctx, topicName, events...)
+
+}
+
+/*
+ *	publishErrorCodes
+ *	Names of the error codes of publish results.
+ *	@since	2.0.0
+ */
+var publishErrorCodes = map[uint64]string{
+	0: "UNKNOWN",
+	1: "PUBLISH",
+	2: "COMMIT",
+}
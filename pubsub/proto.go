@@ -0,0 +1,165 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"encoding/binary"
+	"errors"
+)
+
+/*
+ *	Protocol Buffers wire types used by the Pub/Sub API.
+ *	@since	2.0.0
+ */
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+/*
+ *	errMalformed
+ *	Returned when a message cannot be decoded.
+ *	@since	2.0.0
+ */
+var errMalformed = errors.New("pubsub: malformed message")
+
+/*
+ *	appendVarint
+ *	Appends a varint field, unless it is zero.
+ *	@since	2.0.0
+ */
+func appendVarint(b []byte, field int, value uint64) []byte {
+
+	if value == 0 {
+		return b
+	}
+
+	b = binary.AppendUvarint(b, uint64(field)<<3|wireVarint)
+
+	return binary.AppendUvarint(b, value)
+
+}
+
+/*
+ *	appendBytes
+ *	Appends a length-delimited field, unless it is empty.
+ *	@since	2.0.0
+ */
+func appendBytes(b []byte, field int, value []byte) []byte {
+
+	if len(value) == 0 {
+		return b
+	}
+
+	b = binary.AppendUvarint(b, uint64(field)<<3|wireBytes)
+	b = binary.AppendUvarint(b, uint64(len(value)))
+
+	return append(b, value...)
+
+}
+
+/*
+ *	appendString
+ *	Appends a string field, unless it is empty.
+ *	@since	2.0.0
+ */
+func appendString(b []byte, field int, value string) []byte {
+
+	return appendBytes(b, field, []byte(value))
+
+}
+
+/*
+ *	appendMessage
+ *	Appends an embedded message field, even if it is empty.
+ *	@since	2.0.0
+ */
+func appendMessage(b []byte, field int, message []byte) []byte {
+
+	b = binary.AppendUvarint(b, uint64(field)<<3|wireBytes)
+	b = binary.AppendUvarint(b, uint64(len(message)))
+
+	return append(b, message...)
+
+}
+
+/*
+ *	field
+ *	A decoded field: its number, and its value as a varint or as bytes.
+ *	@since	2.0.0
+ */
+type field struct {
+	number int
+	varint uint64
+	bytes  []byte
+}
+
+/*
+ *	decodeFields
+ *	Calls fn with each field of a message, skipping the wire types the Pub/Sub API does not use.
+ *	@since	2.0.0
+ */
+func decodeFields(b []byte, fn func(f field) error) error {
+
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errMalformed
+		}
+		b = b[n:]
+
+		f := field{number: int(key >> 3)}
+
+		switch key & 7 {
+		case wireVarint:
+			if f.varint, n = binary.Uvarint(b); n <= 0 {
+				return errMalformed
+			}
+			b = b[n:]
+		case wireBytes:
+			length, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < length {
+				return errMalformed
+			}
+			f.bytes = b[n : n+int(length)]
+			b = b[n+int(length):]
+		case 1:
+			if len(b) < 8 {
+				return errMalformed
+			}
+			b = b[8:]
+			continue
+		case 5:
+			if len(b) < 4 {
+				return errMalformed
+			}
+			b = b[4:]
+			continue
+		default:
+			return errMalformed
+		}
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
@@ -0,0 +1,239 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+/*
+ *	collectFields
+ *	Decodes the fields of a message into a slice.
+ *	@since	2.0.0
+ */
+func collectFields(b []byte) ([]field, error) {
+
+	var fields []field
+
+	err := decodeFields(b, func(f field) error {
+		fields = append(fields, f)
+		return nil
+	})
+
+	return fields, err
+
+}
+
+/*
+ *	TestFieldsRoundTrip
+ *	@since	2.0.0
+ */
+func TestFieldsRoundTrip(t *testing.T) {
+
+	var b []byte
+	b = appendVarint(b, 1, 1)
+	b = appendVarint(b, 2, 0)
+	b = appendVarint(b, 3, 300)
+	b = appendVarint(b, 4, math.MaxUint64)
+	b = appendString(b, 5, "topic")
+	b = appendString(b, 6, "")
+	b = appendBytes(b, 7, []byte{0, 1, 2})
+	b = appendMessage(b, 8, nil)
+	b = appendVarint(b, 536870911, 7)
+
+	got, err := collectFields(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Zero varints and empty strings are omitted; empty messages are not.
+	want := []field{
+		{number: 1, varint: 1},
+		{number: 3, varint: 300},
+		{number: 4, varint: math.MaxUint64},
+		{number: 5, bytes: []byte("topic")},
+		{number: 7, bytes: []byte{0, 1, 2}},
+		{number: 8, bytes: []byte{}},
+		{number: 536870911, varint: 7},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded %+v, want %+v", got, want)
+	}
+
+}
+
+/*
+ *	TestFieldsWireFormat
+ *	@since	2.0.0
+ */
+func TestFieldsWireFormat(t *testing.T) {
+
+	tests := []struct {
+		name string
+		got  []byte
+		want []byte
+	}{
+		{"varint", appendVarint(nil, 1, 150), []byte{0x08, 0x96, 0x01}},
+		{"string", appendString(nil, 2, "testing"), []byte{0x12, 0x07, 't', 'e', 's', 't', 'i', 'n', 'g'}},
+		{"empty message", appendMessage(nil, 4, nil), []byte{0x22, 0x00}},
+		{"field 16", appendVarint(nil, 16, 1), []byte{0x80, 0x01, 0x01}},
+	}
+
+	for _, test := range tests {
+		if !bytes.Equal(test.got, test.want) {
+			t.Errorf("%s: encoded % x, want % x", test.name, test.got, test.want)
+		}
+	}
+
+}
+
+/*
+ *	TestDecodeFieldsSkipsFixed
+ *	@since	2.0.0
+ */
+func TestDecodeFieldsSkipsFixed(t *testing.T) {
+
+	// A fixed64 field 1, a fixed32 field 2, then a varint field 3.
+	b := []byte{0x09, 1, 2, 3, 4, 5, 6, 7, 8, 0x15, 1, 2, 3, 4, 0x18, 0x05}
+
+	got, err := collectFields(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []field{{number: 3, varint: 5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded %+v, want %+v", got, want)
+	}
+
+}
+
+/*
+ *	TestDecodeFieldsMalformed
+ *	@since	2.0.0
+ */
+func TestDecodeFieldsMalformed(t *testing.T) {
+
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"truncated key", []byte{0x80}},
+		{"truncated varint", []byte{0x08, 0x96}},
+		{"missing varint", []byte{0x08}},
+		{"truncated length", []byte{0x12, 0x80}},
+		{"length past end", []byte{0x12, 0x05, 'a', 'b'}},
+		{"huge length", []byte{0x12, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{"truncated fixed64", []byte{0x09, 1, 2, 3}},
+		{"truncated fixed32", []byte{0x15, 1}},
+		{"group", []byte{0x0b}},
+		{"invalid wire type", []byte{0x0f}},
+	}
+
+	for _, test := range tests {
+		if _, err := collectFields(test.b); !errors.Is(err, errMalformed) {
+			t.Errorf("%s: error = %v, want errMalformed", test.name, err)
+		}
+	}
+
+}
+
+/*
+ *	TestProducerEventRoundTrip
+ *	@since	2.0.0
+ */
+func TestProducerEventRoundTrip(t *testing.T) {
+
+	event := ProducerEvent{
+		Id:       "a1b2",
+		SchemaId: "schema-1",
+		Payload:  []byte{0x02, 0x00, 0xff},
+		Headers: []EventHeader{
+			{Key: "sfdc.orgId", Value: []byte("00D")},
+			{Key: "empty"},
+		},
+	}
+
+	got, err := decodeProducerEvent(event.encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, event) {
+		t.Errorf("decoded %+v, want %+v", got, event)
+	}
+
+}
+
+/*
+ *	FuzzDecodeFields
+ *	Checks that decoding never panics, and that the fields decoded encode back to the same fields.
+ *	@since	2.0.0
+ */
+func FuzzDecodeFields(f *testing.F) {
+
+	f.Add([]byte{})
+	f.Add(appendVarint(appendString(nil, 1, "/event/Order_Placed__e"), 4, 100))
+	f.Add((&ProducerEvent{Id: "1", SchemaId: "s", Payload: []byte{1, 2}, Headers: []EventHeader{{Key: "k"}}}).encode())
+	f.Add([]byte{0x09, 1, 2, 3, 4, 5, 6, 7, 8, 0x15, 1, 2, 3, 4})
+	f.Add([]byte{0x12, 0xff, 0xff, 0xff, 0xff, 0x0f})
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fields, err := collectFields(b)
+		if err != nil {
+			if !errors.Is(err, errMalformed) {
+				t.Fatalf("error = %v, want errMalformed", err)
+			}
+			return
+		}
+
+		var encoded []byte
+		var want []field
+
+		for _, f := range fields {
+			if f.bytes != nil {
+				encoded = appendMessage(encoded, f.number, f.bytes)
+				want = append(want, f)
+			} else if f.varint != 0 {
+				encoded = appendVarint(encoded, f.number, f.varint)
+				want = append(want, f)
+			}
+		}
+
+		got, err := collectFields(encoded)
+		if err != nil {
+			t.Fatalf("decoding re-encoded fields: %v", err)
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("re-encoded %d fields, decoded %d", len(want), len(got))
+		}
+
+		for i := range got {
+			if got[i].number != want[i].number || got[i].varint != want[i].varint || !bytes.Equal(got[i].bytes, want[i].bytes) {
+				t.Fatalf("field %d: decoded %+v, want %+v", i, got[i], want[i])
+			}
+		}
+	})
+
+}
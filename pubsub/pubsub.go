@@ -0,0 +1,524 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Endpoint of the Pub/Sub API.
+ *	@since	2.0.0
+ */
+const DefaultEndpoint string = "https://api.pubsub.salesforce.com:7443"
+
+/*
+ *	Client
+ *	A client for the Salesforce Pub/Sub API, which publishes and subscribes to platform events
+ *	and change events over gRPC.
+ *	Requests are sent through the HTTP client of the salesforce.Client, whose transport must support HTTP/2,
+ *	as http.DefaultTransport does.
+ *	A Client is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
+ */
+type Client struct {
+	// Client of the org, used to authenticate and send requests.
+	client *salesforce.Client
+
+	// URL of the Pub/Sub API.
+	endpoint string
+
+	// ID of the org, if not taken from the identity URL of the client.
+	tenantId string
+
+	// Parsed Avro schemas by ID.
+	schemas sync.Map
+
+	// Stores the replay ID of the last event handled on each topic, if set.
+	replayStore salesforce.ReplayStore
+
+	// Backoff between reconnection attempts; MaxAttempts limits consecutive failures, unlimited if 0.
+	reconnectPolicy salesforce.RetryPolicy
+
+	// Called when the state of a subscription changes, if set.
+	stateCallback func(state salesforce.ConnectionState, err error)
+}
+
+/*
+ *	Option
+ *	Configures a Client.
+ *	@since	2.0.0
+ */
+type Option func(*Client)
+
+/*
+ *	WithEndpoint
+ *	Sets the URL of the Pub/Sub API, instead of DefaultEndpoint.
+ *	@since	2.0.0
+ */
+func WithEndpoint(endpoint string) Option {
+
+	return func(p *Client) {
+		p.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+
+}
+
+/*
+ *	WithTenantId
+ *	Sets the ID of the org. By default it is taken from the identity URL returned with the access token.
+ *	@since	2.0.0
+ */
+func WithTenantId(orgId string) Option {
+
+	return func(p *Client) {
+		p.tenantId = orgId
+	}
+
+}
+
+/*
+ *	WithReplayStore
+ *	Stores the replay ID of each event once its handler returns, and resumes subscriptions after the stored IDs,
+ *	in preference to the replay settings of the SubscribeRequest.
+ *	@since	2.0.0
+ */
+func WithReplayStore(store salesforce.ReplayStore) Option {
+
+	return func(p *Client) {
+		p.replayStore = store
+	}
+
+}
+
+/*
+ *	WithReconnect
+ *	Sets the backoff between reconnection attempts after a subscription stream fails or is closed by the server.
+ *	MaxAttempts limits consecutive failed attempts; by default, subscriptions reconnect indefinitely.
+ *	@since	2.0.0
+ */
+func WithReconnect(policy salesforce.RetryPolicy) Option {
+
+	return func(p *Client) {
+		p.reconnectPolicy = policy
+	}
+
+}
+
+/*
+ *	WithStateCallback
+ *	Sets a function called when the state of a subscription changes,
+ *	with the error that caused reconnecting or disconnecting, if any.
+ *	@since	2.0.0
+ */
+func WithStateCallback(callback func(state salesforce.ConnectionState, err error)) Option {
+
+	return func(p *Client) {
+		p.stateCallback = callback
+	}
+
+}
+
+/*
+ *	NewClient
+ *	Returns a Pub/Sub API client authenticated by the given client.
+ *	@since	2.0.0
+ */
+func NewClient(client *salesforce.Client, opts ...Option) *Client {
+
+	p := &Client{
+		client:   client,
+		endpoint: DefaultEndpoint,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+
+}
+
+/*
+ *	orgId
+ *	Returns the ID of the org, from the option or from the identity URL, e.g. https://login.salesforce.com/id/00D.../005....
+ *	@since	2.0.0
+ */
+func (p *Client) orgId() (string, error) {
+
+	if p.tenantId != "" {
+		return p.tenantId, nil
+	}
+
+	identityUrl, err := url.Parse(p.client.IdentityUrl())
+	if err == nil {
+		segments := strings.Split(strings.Trim(identityUrl.Path, "/"), "/")
+		if len(segments) == 3 && segments[0] == "id" {
+			return segments[1], nil
+		}
+	}
+
+	return "", errors.New("pubsub: org ID unknown; set it with WithTenantId")
+
+}
+
+/*
+ *	newRequest
+ *	Returns a new request calling a method of the Pub/Sub service, with the given body.
+ *	@since	2.0.0
+ */
+func (p *Client) newRequest(ctx context.Context, method string, body io.Reader) (*http.Request, error) {
+
+	orgId, err := p.orgId()
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := p.client.NewRequest(ctx, http.MethodPost, p.endpoint+"/eventbus.v1.PubSub/"+method, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The body is sent as a stream, neither compressed nor replayed.
+	request.GetBody = nil
+	request.ContentLength = -1
+
+	// The access token is sent without its type.
+	accessToken := p.client.OAuth2AccessToken()
+	if _, token, ok := strings.Cut(accessToken, " "); ok {
+		accessToken = token
+	}
+
+	request.Header.Set("Content-Type", "application/grpc")
+	request.Header.Set("TE", "trailers")
+	request.Header.Set("accesstoken", accessToken)
+	request.Header.Set("instanceurl", p.client.InstanceUrl())
+	request.Header.Set("tenantid", orgId)
+
+	return request, nil
+
+}
+
+/*
+ *	invoke
+ *	Calls a unary method of the Pub/Sub service and returns the encoded response.
+ *	@since	2.0.0
+ */
+func (p *Client) invoke(ctx context.Context, method string, message []byte) ([]byte, error) {
+
+	request, err := p.newRequest(ctx, method, bytes.NewReader(frame(message)))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	reply, err := readFrame(response.Body)
+	if err == io.EOF {
+		io.Copy(io.Discard, response.Body)
+		if err := status(response); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	io.Copy(io.Discard, response.Body)
+
+	if err := status(response); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+
+}
+
+/*
+ *	frame
+ *	Returns a message framed as gRPC requires: uncompressed, prefixed with its length.
+ *	@since	2.0.0
+ */
+func frame(message []byte) []byte {
+
+	framed := make([]byte, 5, 5+len(message))
+	binary.BigEndian.PutUint32(framed[1:], uint32(len(message)))
+
+	return append(framed, message...)
+
+}
+
+/*
+ *	readFrame
+ *	Reads a single gRPC message. Returns io.EOF at the end of the stream.
+ *	@since	2.0.0
+ */
+func readFrame(r io.Reader) ([]byte, error) {
+
+	var prefix [5]byte
+
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, err
+	}
+
+	if prefix[0] != 0 {
+		return nil, errors.New("pubsub: compressed messages are not supported")
+	}
+
+	message := make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+
+	if _, err := io.ReadFull(r, message); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return message, nil
+
+}
+
+/*
+ *	Error
+ *	A gRPC error returned by the Pub/Sub API, e.g. code 16 (Unauthenticated) or 7 (Permission Denied).
+ *	@since	2.0.0
+ */
+type Error struct {
+	Code    int
+	Message string
+
+	// Salesforce error code, e.g. sfdc.platform.eventbus.grpc.service.auth.error.
+	ErrorCode string
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *Error) Error() string {
+
+	if e.ErrorCode != "" {
+		return "pubsub: " + e.ErrorCode + ": " + e.Message
+	}
+
+	return "pubsub: grpc status " + strconv.Itoa(e.Code) + ": " + e.Message
+
+}
+
+/*
+ *	temporary
+ *	Reports whether the error may be resolved by retrying the call, e.g. code 14 (Unavailable).
+ *	@since	2.0.0
+ */
+func (e *Error) temporary() bool {
+
+	switch e.Code {
+	case 2, 4, 8, 10, 13, 14:
+		return true
+	}
+
+	return false
+
+}
+
+/*
+ *	unauthenticated
+ *	Reports whether the error is code 16 (Unauthenticated), returned when the access token has expired.
+ *	@since	2.0.0
+ */
+func (e *Error) unauthenticated() bool {
+
+	return e.Code == 16
+
+}
+
+/*
+ *	status
+ *	Returns the error reported by the gRPC status of a response, read after its body,
+ *	or from its headers if the response has no body.
+ *	@since	2.0.0
+ */
+func status(response *http.Response) error {
+
+	header := response.Trailer
+	if header.Get("Grpc-Status") == "" {
+		header = response.Header
+	}
+
+	code, err := strconv.Atoi(header.Get("Grpc-Status"))
+	if err != nil {
+		return errors.New("pubsub: missing grpc status")
+	}
+
+	if code == 0 {
+		return nil
+	}
+
+	message, _ := url.PathUnescape(header.Get("Grpc-Message"))
+
+	return &Error{
+		Code:      code,
+		Message:   message,
+		ErrorCode: header.Get("Error-Code"),
+	}
+
+}
+
+/*
+ *	TopicInfo
+ *	A topic, e.g. /event/Order_Placed__e, and the permissions of the user on it.
+ *	@since	2.0.0
+ */
+type TopicInfo struct {
+	TopicName    string
+	TenantGuid   string
+	CanPublish   bool
+	CanSubscribe bool
+	SchemaId     string
+	RpcId        string
+}
+
+/*
+ *	GetTopic
+ *	Returns a topic, including the ID of the schema of its events.
+ *	@since	2.0.0
+ */
+func (p *Client) GetTopic(ctx context.Context, topicName string) (*TopicInfo, error) {
+
+	reply, err := p.invoke(ctx, "GetTopic", appendString(nil, 1, topicName))
+	if err != nil {
+		return nil, err
+	}
+
+	var topic TopicInfo
+
+	err = decodeFields(reply, func(f field) error {
+		switch f.number {
+		case 1:
+			topic.TopicName = string(f.bytes)
+		case 2:
+			topic.TenantGuid = string(f.bytes)
+		case 3:
+			topic.CanPublish = f.varint != 0
+		case 4:
+			topic.CanSubscribe = f.varint != 0
+		case 5:
+			topic.SchemaId = string(f.bytes)
+		case 6:
+			topic.RpcId = string(f.bytes)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &topic, nil
+
+}
+
+/*
+ *	SchemaInfo
+ *	The Avro schema of events, as JSON.
+ *	@since	2.0.0
+ */
+type SchemaInfo struct {
+	SchemaJson string
+	SchemaId   string
+	RpcId      string
+}
+
+/*
+ *	GetSchema
+ *	Returns the Avro schema with the given ID, used to encode and decode event payloads.
+ *	@since	2.0.0
+ */
+func (p *Client) GetSchema(ctx context.Context, schemaId string) (*SchemaInfo, error) {
+
+	reply, err := p.invoke(ctx, "GetSchema", appendString(nil, 1, schemaId))
+	if err != nil {
+		return nil, err
+	}
+
+	var schema SchemaInfo
+
+	err = decodeFields(reply, func(f field) error {
+		switch f.number {
+		case 1:
+			schema.SchemaJson = string(f.bytes)
+		case 2:
+			schema.SchemaId = string(f.bytes)
+		case 3:
+			schema.RpcId = string(f.bytes)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema, nil
+
+}
+
+/*
+ *	schema
+ *	Returns the parsed Avro schema with the given ID, caching it, as schemas never change.
+ *	@since	2.0.0
+ */
+func (p *Client) schema(ctx context.Context, schemaId string) (*schema, error) {
+
+	if cached, ok := p.schemas.Load(schemaId); ok {
+		return cached.(*schema), nil
+	}
+
+	info, err := p.GetSchema(ctx, schemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseSchema(info.SchemaJson)
+	if err != nil {
+		return nil, err
+	}
+
+	p.schemas.Store(schemaId, parsed)
+
+	return parsed, nil
+
+}
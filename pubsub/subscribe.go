@@ -0,0 +1,398 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	ReplayPreset
+ *	Selects where a subscription starts.
+ *	@since	2.0.0
+ */
+type ReplayPreset int
+
+/*
+ *	Replay presets.
+ *	@since	2.0.0
+ */
+const (
+	// Receive only events published after subscribing.
+	ReplayLatest ReplayPreset = 0
+
+	// Receive all events retained by Salesforce, then new events.
+	ReplayEarliest ReplayPreset = 1
+
+	// Receive the events published after the event with the given replay ID.
+	ReplayCustom ReplayPreset = 2
+)
+
+/*
+ *	SubscribeRequest
+ *	Selects the topic of a subscription, where it starts, and how many events are requested at a time.
+ *	@since	2.0.0
+ */
+type SubscribeRequest struct {
+	TopicName    string
+	ReplayPreset ReplayPreset
+
+	// Replay ID of the event to resume after, with ReplayCustom.
+	ReplayId []byte
+
+	// Number of events requested at a time, up to 100; more are requested once they have been received.
+	// Defaults to 100.
+	BatchSize int
+}
+
+/*
+ *	fetchRequest
+ *	Encodes a FetchRequest. The topic and replay settings are only needed in the first.
+ *	@since	2.0.0
+ */
+func fetchRequest(request SubscribeRequest, first bool) []byte {
+
+	var b []byte
+
+	if first {
+		b = appendString(b, 1, request.TopicName)
+		b = appendVarint(b, 2, uint64(request.ReplayPreset))
+		b = appendBytes(b, 3, request.ReplayId)
+	}
+
+	return appendVarint(b, 4, uint64(request.BatchSize))
+
+}
+
+/*
+ *	Subscribe
+ *	Subscribes to a topic and calls handler with each event received, sequentially,
+ *	until the context is cancelled or handler returns an error, which is then returned.
+ *	When the stream fails or is closed by the server, Subscribe reconnects after a backoff,
+ *	resuming after the last event handled, and returns once reconnecting fails too many times
+ *	or the error is not temporary, e.g. code 7 (Permission Denied).
+ *	When the access token expires, code 16 (Unauthenticated), it is renewed before reconnecting.
+ *	Events are requested in batches as earlier ones are received, so a slow handler slows the flow of events.
+ *	Payloads are Avro-encoded; use Decode or DecodeChangeEvent to decode them.
+ *	@since	2.0.0
+ */
+func (p *Client) Subscribe(ctx context.Context, request SubscribeRequest, handler func(ctx context.Context, event ConsumerEvent) error) error {
+
+	if request.BatchSize <= 0 || request.BatchSize > 100 {
+		request.BatchSize = 100
+	}
+
+	if p.replayStore != nil {
+		replayId, err := p.replayStore.Get(ctx, request.TopicName)
+		if err != nil {
+			return err
+		}
+		if replayId != nil {
+			request.ReplayPreset, request.ReplayId = ReplayCustom, replayId
+		}
+	}
+
+	p.setState(salesforce.StateConnecting, nil)
+
+	failures := 0
+	for {
+		connected, err := p.subscribe(ctx, &request, handler)
+		if ctx.Err() != nil {
+			p.setState(salesforce.StateDisconnected, ctx.Err())
+			return ctx.Err()
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			p.setState(salesforce.StateDisconnected, permanent.err)
+			return permanent.err
+		}
+
+		if !reconnectable(err) {
+			p.setState(salesforce.StateDisconnected, err)
+			return err
+		}
+
+		if connected {
+			failures = 0
+		}
+		failures++
+
+		if p.reconnectPolicy.MaxAttempts > 0 && failures >= p.reconnectPolicy.MaxAttempts {
+			p.setState(salesforce.StateDisconnected, err)
+			return err
+		}
+
+		p.setState(salesforce.StateReconnecting, err)
+
+		select {
+		case <-ctx.Done():
+			p.setState(salesforce.StateDisconnected, ctx.Err())
+			return ctx.Err()
+		case <-time.After(p.reconnectPolicy.Backoff(failures)):
+		}
+	}
+
+}
+
+/*
+ *	subscribe
+ *	Opens a subscription stream and delivers its events until it ends, and returns why.
+ *	Reports whether the stream was established, so that failures are counted from the last connection.
+ *	The request is updated after each event handled, to resume after it.
+ *	@since	2.0.0
+ */
+func (p *Client) subscribe(ctx context.Context, request *SubscribeRequest, handler func(ctx context.Context, event ConsumerEvent) error) (bool, error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	reader, writer := io.Pipe()
+	defer writer.Close()
+
+	httpRequest, err := p.newRequest(ctx, "Subscribe", reader)
+	if err != nil {
+		return false, err
+	}
+
+	// Requests are written as the stream needs them; the first is written while the call is set up.
+	requests := make(chan []byte, 1)
+	requests <- fetchRequest(*request, true)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message := <-requests:
+				if _, err := writer.Write(frame(message)); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	response, err := p.client.Do(httpRequest)
+	if err != nil {
+		return false, err
+	}
+
+	defer response.Body.Close()
+
+	// A call refused by the server fails with headers only.
+	if response.Header.Get("Grpc-Status") != "" {
+		return false, p.renewIfUnauthenticated(ctx, httpRequest, status(response))
+	}
+
+	p.setState(salesforce.StateConnected, nil)
+
+	for {
+		message, err := readFrame(response.Body)
+		if err == io.EOF {
+			if err := status(response); err != nil {
+				return true, p.renewIfUnauthenticated(ctx, httpRequest, err)
+			}
+			return true, errStreamClosed
+		}
+		if err != nil {
+			return true, &streamError{err}
+		}
+
+		var pending uint64
+		var events []ConsumerEvent
+
+		err = decodeFields(message, func(f field) error {
+			switch f.number {
+			case 1:
+				var event ConsumerEvent
+				err := decodeFields(f.bytes, func(f field) error {
+					var err error
+					switch f.number {
+					case 1:
+						event.Event, err = decodeProducerEvent(f.bytes)
+					case 2:
+						event.ReplayId = f.bytes
+					}
+					return err
+				})
+				events = append(events, event)
+				return err
+			case 4:
+				pending = f.varint
+			}
+			return nil
+		})
+
+		if err != nil {
+			return true, &permanentError{err}
+		}
+
+		for _, event := range events {
+			if err := handler(ctx, event); err != nil {
+				return true, &permanentError{err}
+			}
+			request.ReplayPreset, request.ReplayId = ReplayCustom, event.ReplayId
+			if p.replayStore != nil {
+				if err := p.replayStore.Set(ctx, request.TopicName, event.ReplayId); err != nil {
+					return true, &permanentError{err}
+				}
+			}
+		}
+
+		// Keep-alive responses carry no events.
+		if len(events) > 0 && pending == 0 {
+			select {
+			case requests <- fetchRequest(*request, false):
+			case <-ctx.Done():
+				return true, ctx.Err()
+			}
+		}
+	}
+
+}
+
+/*
+ *	renewIfUnauthenticated
+ *	Renews the access token of the client if a stream failed with code 16 (Unauthenticated),
+ *	so that reconnecting resumes the subscription. Returns err, or why the token could not be renewed.
+ *	@since	2.0.0
+ */
+func (p *Client) renewIfUnauthenticated(ctx context.Context, request *http.Request, err error) error {
+
+	var grpcError *Error
+	if !errors.As(err, &grpcError) || !grpcError.unauthenticated() {
+		return err
+	}
+
+	if renewErr := p.client.RenewOAuth2AccessToken(ctx, request.Header.Get("Authorization")); renewErr != nil {
+		return &permanentError{errors.Join(err, renewErr)}
+	}
+
+	return err
+
+}
+
+/*
+ *	errStreamClosed
+ *	Returned when the server ends a subscription stream without an error.
+ *	@since	2.0.0
+ */
+var errStreamClosed = errors.New("pubsub: stream closed by server")
+
+/*
+ *	streamError
+ *	An error reading the events of an established subscription stream, e.g. a reset connection.
+ *	@since	2.0.0
+ */
+type streamError struct {
+	err error
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *streamError) Error() string {
+
+	return e.err.Error()
+
+}
+
+/*
+ *	Unwrap
+ *	@since	2.0.0
+ */
+func (e *streamError) Unwrap() error {
+
+	return e.err
+
+}
+
+/*
+ *	reconnectable
+ *	Reports whether a subscription that failed with the given error may be resumed by reconnecting:
+ *	network and stream failures, temporary gRPC errors, expired access tokens once renewed, and server errors.
+ *	Other errors, e.g. a missing org ID or an exceeded API budget, would fail again and are returned.
+ *	@since	2.0.0
+ */
+func reconnectable(err error) bool {
+
+	var grpcError *Error
+	if errors.As(err, &grpcError) {
+		return grpcError.temporary() || grpcError.unauthenticated()
+	}
+
+	var salesforceError *salesforce.SalesforceError
+	if errors.As(err, &salesforceError) {
+		return salesforceError.StatusCode == http.StatusTooManyRequests || salesforceError.StatusCode >= 500
+	}
+
+	var stream *streamError
+	if errors.Is(err, errStreamClosed) || errors.As(err, &stream) {
+		return true
+	}
+
+	// The HTTP client wraps the errors of the transport in a *url.Error.
+	var urlError *url.Error
+	var netError net.Error
+
+	return errors.As(err, &urlError) || errors.As(err, &netError) || errors.Is(err, io.ErrUnexpectedEOF)
+
+}
+
+/*
+ *	setState
+ *	Reports a change of the state of a subscription to the state callback, if set.
+ *	@since	2.0.0
+ */
+func (p *Client) setState(state salesforce.ConnectionState, err error) {
+
+	if p.stateCallback != nil {
+		p.stateCallback(state, err)
+	}
+
+}
+
+/*
+ *	permanentError
+ *	An error that reconnecting would not resolve, e.g. returned by a handler.
+ *	@since	2.0.0
+ */
+type permanentError struct {
+	err error
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *permanentError) Error() string {
+
+	return e.err.Error()
+
+}
@@ -0,0 +1,110 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package pubsub
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	TestSubscribeUnknownOrgId
+ *	A client without an org ID cannot subscribe, so Subscribe must fail at once rather than reconnect forever.
+ *	@since	2.0.0
+ */
+func TestSubscribeUnknownOrgId(t *testing.T) {
+
+	var requests int
+
+	transport := salesforce.RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		requests++
+		return nil, errors.New("unexpected request")
+	})
+
+	client := salesforce.NewClient(
+		salesforce.WithInstanceUrl("https://example.my.salesforce.com"),
+		salesforce.WithOAuth2AccessToken("Bearer token"),
+		salesforce.WithTransport(transport),
+	)
+
+	var states []salesforce.ConnectionState
+
+	p := NewClient(client, WithStateCallback(func(state salesforce.ConnectionState, err error) {
+		states = append(states, state)
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := p.Subscribe(ctx, SubscribeRequest{TopicName: "/event/Order_Placed__e"}, func(ctx context.Context, event ConsumerEvent) error {
+		return nil
+	})
+
+	if err == nil || ctx.Err() != nil || !strings.Contains(err.Error(), "org ID unknown") {
+		t.Fatalf("error = %v, want the unknown org ID error", err)
+	}
+
+	if requests != 0 {
+		t.Errorf("%d requests sent, want none", requests)
+	}
+
+	if want := []salesforce.ConnectionState{salesforce.StateConnecting, salesforce.StateDisconnected}; !slices.Equal(states, want) {
+		t.Errorf("states = %v, want %v", states, want)
+	}
+
+}
+
+/*
+ *	TestReconnectable
+ *	@since	2.0.0
+ */
+func TestReconnectable(t *testing.T) {
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"stream closed", errStreamClosed, true},
+		{"stream read", &streamError{errors.New("http2: stream reset")}, true},
+		{"unexpected EOF", io.ErrUnexpectedEOF, true},
+		{"unavailable", &Error{Code: 14}, true},
+		{"unauthenticated", &Error{Code: 16}, true},
+		{"permission denied", &Error{Code: 7}, false},
+		{"server error", &salesforce.SalesforceError{StatusCode: 503}, true},
+		{"bad request", &salesforce.SalesforceError{StatusCode: 400}, false},
+		{"budget exceeded", salesforce.ErrApiBudgetExceeded, false},
+		{"configuration", errors.New("pubsub: org ID unknown; set it with WithTenantId"), false},
+	}
+
+	for _, test := range tests {
+		if got := reconnectable(test.err); got != test.want {
+			t.Errorf("%s: reconnectable = %v, want %v", test.name, got, test.want)
+		}
+	}
+
+}
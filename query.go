@@ -0,0 +1,365 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	ErrNoMoreRecords
+ *	Returned by QueryResult.Next when all records of a query have been retrieved.
+ *	@since	2.0.0
+ */
+var ErrNoMoreRecords = errors.New("salesforce: no more records")
+
+/*
+ *	queryOptions
+ *	Returns the value of the Sforce-Query-Options header for the given batch size,
+ *	clamped to the range accepted by Salesforce.
+ *	@since	2.0.0
+ */
+func queryOptions(batchSize int) string {
+
+	return "batchSize=" + strconv.Itoa(min(max(batchSize, 200), 2000))
+
+}
+
+/*
+ *	WithQueryBatchSize
+ *	Sets the number of records returned per page of query results, from 200 to 2000.
+ *	Smaller pages suit wide rows; larger pages need fewer calls to walk all records.
+ *	Salesforce treats the batch size as a hint and may return fewer records.
+ *	@since	2.0.0
+ */
+func WithQueryBatchSize(batchSize int) Option {
+
+	return WithHeader("Sforce-Query-Options", queryOptions(batchSize))
+
+}
+
+/*
+ *	ContextWithQueryBatchSize
+ *	Returns a context that sets the number of records returned per page of query results, from 200 to 2000.
+ *	@since	2.0.0
+ */
+func ContextWithQueryBatchSize(ctx context.Context, batchSize int) context.Context {
+
+	return ContextWithHeader(ctx, "Sforce-Query-Options", queryOptions(batchSize))
+
+}
+
+/*
+ *	QueryResult
+ *	A page of records returned by a SOQL query.
+ *	@since	2.0.0
+ */
+type QueryResult struct {
+	TotalSize      int               `json:"totalSize"`
+	Done           bool              `json:"done"`
+	NextRecordsUrl string            `json:"nextRecordsUrl"`
+	Records        []json.RawMessage `json:"records"`
+
+	// Client used to retrieve the next page.
+	client *Client
+}
+
+/*
+ *	HasNext
+ *	Reports whether there are more pages of records to retrieve.
+ *	@since	2.0.0
+ */
+func (r *QueryResult) HasNext() bool {
+
+	return !r.Done && r.NextRecordsUrl != ""
+
+}
+
+/*
+ *	Next
+ *	Retrieves the next page of records.
+ *	Returns ErrNoMoreRecords if this is the last page.
+ *	@since	2.0.0
+ */
+func (r *QueryResult) Next(ctx context.Context) (*QueryResult, error) {
+
+	if !r.HasNext() {
+		return nil, ErrNoMoreRecords
+	}
+
+	return r.client.QueryMore(ctx, r.NextRecordsUrl)
+
+}
+
+/*
+ *	QueryRecords
+ *	Executes a SOQL query and returns the first page of records.
+ *	Use QueryResult.Next to retrieve the remaining pages.
+ *	@since	2.0.0
+ */
+func (c *Client) QueryRecords(ctx context.Context, soql string) (*QueryResult, error) {
+
+	return c.queryRecords(ctx, c.dataUrl(ctx, "query/?q="+url.QueryEscape(soql)))
+
+}
+
+/*
+ *	QueryWithParams
+ *	Executes a SOQL query after binding params to its ? placeholders, e.g.
+ *	QueryWithParams(ctx, "SELECT Id FROM Account WHERE Name = ? AND Id IN ?", name, ids).
+ *	Strings are quoted and escaped, so params are safe from SOQL injection.
+ *	See soql.Format for the supported parameter types.
+ *	Returns the JSON representation of the first page of records.
+ *	@since	2.0.0
+ */
+func (c *Client) QueryWithParams(ctx context.Context, query string, params ...interface{}) ([]byte, error) {
+
+	statement, err := soql.Format(query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.queryRaw(ctx, "query", statement)
+
+}
+
+/*
+ *	QueryAll
+ *	Executes a SOQL query that includes deleted and archived records, e.g. archived Tasks and Events.
+ *	Returns the JSON representation of the first page of records.
+ *	@since	2.0.0
+ */
+func (c *Client) QueryAll(ctx context.Context, soql string) ([]byte, error) {
+
+	return c.queryRaw(ctx, "queryAll", soql)
+
+}
+
+/*
+ *	queryRaw
+ *	Executes a SOQL query against the query or queryAll resource,
+ *	and returns the JSON representation of the first page of records.
+ *	@since	2.0.0
+ */
+func (c *Client) queryRaw(ctx context.Context, resource string, soql string) ([]byte, error) {
+
+	request, err := c.newRequest(ctx, http.MethodGet, c.dataUrl(ctx, resource+"/?q="+url.QueryEscape(soql)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	return io.ReadAll(response.Body)
+
+}
+
+/*
+ *	QueryAllRecords
+ *	Executes a SOQL query that includes deleted and archived records and returns the first page of records.
+ *	Use QueryResult.Next to retrieve the remaining pages.
+ *	@since	2.0.0
+ */
+func (c *Client) QueryAllRecords(ctx context.Context, soql string) (*QueryResult, error) {
+
+	return c.queryRecords(ctx, c.dataUrl(ctx, "queryAll/?q="+url.QueryEscape(soql)))
+
+}
+
+/*
+ *	QueryMore
+ *	Retrieves the page of records located by the nextRecordsUrl of a previous QueryResult.
+ *	@since	2.0.0
+ */
+func (c *Client) QueryMore(ctx context.Context, nextRecordsUrl string) (*QueryResult, error) {
+
+	return c.queryRecords(ctx, c.baseUrl()+nextRecordsUrl)
+
+}
+
+/*
+ *	QueryResource
+ *	Executes a SOQL query against a query resource, given as for NewRequest, e.g. "tooling/query",
+ *	and returns the first page of records.
+ *	Use QueryResult.Next to retrieve the remaining pages.
+ *	@since	2.0.0
+ */
+func (c *Client) QueryResource(ctx context.Context, resource string, soql string) (*QueryResult, error) {
+
+	return c.queryRecords(ctx, c.resolveUrl(ctx, resource+"/?q="+url.QueryEscape(soql)))
+
+}
+
+/*
+ *	queryRecords
+ *	@since	2.0.0
+ */
+func (c *Client) queryRecords(ctx context.Context, url string) (*QueryResult, error) {
+
+	result := &QueryResult{client: c}
+
+	if err := c.doJSON(ctx, http.MethodGet, url, nil, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+
+}
+
+/*
+ *	QueryInto
+ *	Executes a SOQL query, retrieves all pages of records, and decodes each record into a T.
+ *	Struct fields are matched using their json tags, or otherwise their sf tags,
+ *	which may name a relationship field, e.g. `sf:"Account.Name"`.
+ *	@since	2.0.0
+ */
+func QueryInto[T any](ctx context.Context, c *Client, soql string) ([]T, error) {
+
+	result, err := c.QueryRecords(ctx, soql)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]T, 0, result.TotalSize)
+
+	for {
+		for _, raw := range result.Records {
+			var record T
+			if err := decodeRecord(raw, &record); err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+
+		if !result.HasNext() {
+			return records, nil
+		}
+
+		if result, err = result.Next(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+/*
+ *	decodeRecord
+ *	Decodes a record into v, which must be a pointer, honouring sf struct tags.
+ *	@since	2.0.0
+ */
+func decodeRecord(data json.RawMessage, v interface{}) error {
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+
+		tag, ok := field.Tag.Lookup("sf")
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		// json tags take precedence.
+		if _, ok := field.Tag.Lookup("json"); ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		raw, ok := lookupField(fields, name)
+		if !ok {
+			continue
+		}
+
+		if err := json.Unmarshal(raw, rv.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	lookupField
+ *	Returns the raw value of a field of a record, following dotted relationship paths.
+ *	@since	2.0.0
+ */
+func lookupField(fields map[string]json.RawMessage, path string) (json.RawMessage, bool) {
+
+	name, rest, nested := strings.Cut(path, ".")
+
+	raw, ok := fields[name]
+	if !ok {
+		for key, value := range fields {
+			if strings.EqualFold(key, name) {
+				raw, ok = value, true
+				break
+			}
+		}
+	}
+
+	if !ok || !nested {
+		return raw, ok
+	}
+
+	var related map[string]json.RawMessage
+	if json.Unmarshal(raw, &related) != nil || related == nil {
+		return nil, false
+	}
+
+	return lookupField(related, rest)
+
+}
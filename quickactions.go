@@ -0,0 +1,186 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+/*
+ *	QuickAction
+ *	A quick action of an object, or a global action, as shown to users in the Salesforce UI.
+ *	@since	2.0.0
+ */
+type QuickAction struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+
+	// Create, Update, LogACall, SendEmail, Post, VisualforcePage, Flow, ...
+	Type string `json:"type"`
+
+	Urls map[string]string `json:"urls"`
+}
+
+/*
+ *	QuickActionDescribe
+ *	The definition of a quick action: its target object and the fields of its layout.
+ *	@since	2.0.0
+ */
+type QuickActionDescribe struct {
+	Name               string          `json:"name"`
+	Label              string          `json:"label"`
+	Type               string          `json:"type"`
+	TargetSobjectType  string          `json:"targetSobjectType"`
+	TargetParentField  string          `json:"targetParentField"`
+	TargetRecordTypeId string          `json:"targetRecordTypeId"`
+	ContextSobjectType string          `json:"contextSobjectType"`
+	SuccessMessage     string          `json:"successMessage"`
+	Layout             json.RawMessage `json:"layout"`
+	DefaultValues      json.RawMessage `json:"defaultValues"`
+}
+
+/*
+ *	QuickActionResult
+ *	The result of invoking a quick action.
+ *	@since	2.0.0
+ */
+type QuickActionResult struct {
+	Id             string            `json:"id"`
+	Ids            []string          `json:"ids"`
+	ContextId      string            `json:"contextId"`
+	Created        bool              `json:"created"`
+	Success        bool              `json:"success"`
+	SuccessMessage string            `json:"successMessage"`
+	FeedItemIds    []string          `json:"feedItemIds"`
+	Errors         []SalesforceError `json:"errors"`
+}
+
+/*
+ *	Err
+ *	Returns the errors reported for the action, or nil if it succeeded.
+ *	@since	2.0.0
+ */
+func (r *QuickActionResult) Err() error {
+
+	if r.Success {
+		return nil
+	}
+
+	return joinErrors(0, r.Errors)
+
+}
+
+/*
+ *	quickActionsPath
+ *	Returns the path of the quick actions of an object, or of the global actions if object is empty.
+ *	@since	2.0.0
+ */
+func quickActionsPath(object string) string {
+
+	if object == "" {
+		return "quickActions/"
+	}
+
+	return "sobjects/" + object + "/quickActions/"
+
+}
+
+/*
+ *	QuickActions
+ *	Returns the quick actions of an object, or the global actions if object is empty.
+ *	@since	2.0.0
+ */
+func (c *Client) QuickActions(ctx context.Context, object string) ([]QuickAction, error) {
+
+	var actions []QuickAction
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, quickActionsPath(object)), nil, &actions); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+
+}
+
+/*
+ *	DescribeQuickAction
+ *	Returns the definition of a quick action of an object, or of a global action if object is empty.
+ *	@since	2.0.0
+ */
+func (c *Client) DescribeQuickAction(ctx context.Context, object string, action string) (*QuickActionDescribe, error) {
+
+	var describe QuickActionDescribe
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, quickActionsPath(object)+action+"/describe/"), nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
+
+/*
+ *	QuickActionDefaults
+ *	Returns the default field values of the record a quick action of an object creates or updates,
+ *	when invoked on the record with the given ID, as configured by admins and formulas.
+ *	@since	2.0.0
+ */
+func (c *Client) QuickActionDefaults(ctx context.Context, object string, action string, contextId string) (map[string]interface{}, error) {
+
+	var defaults map[string]interface{}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, quickActionsPath(object)+action+"/defaultValues/"+contextId), nil, &defaults); err != nil {
+		return nil, err
+	}
+
+	return defaults, nil
+
+}
+
+/*
+ *	InvokeQuickAction
+ *	Invokes a quick action of an object on the record with the given ID, or a global action if object is empty,
+ *	with the field values of the record it creates or updates. Defaults are applied to the fields not given.
+ *	@since	2.0.0
+ */
+func (c *Client) InvokeQuickAction(ctx context.Context, object string, action string, contextId string, record map[string]interface{}) (*QuickActionResult, error) {
+
+	request := map[string]interface{}{
+		"record": record,
+	}
+
+	if contextId != "" {
+		request["contextId"] = contextId
+	}
+
+	var result QuickActionResult
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, quickActionsPath(object)+action), request, &result); err != nil {
+		return nil, err
+	}
+
+	if err := result.Err(); err != nil {
+		return &result, err
+	}
+
+	return &result, nil
+
+}
@@ -0,0 +1,191 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+/*
+ *	ErrApiBudgetExceeded
+ *	Returned when a call would exceed the daily API budget of the client.
+ *	@since	2.0.0
+ */
+var ErrApiBudgetExceeded = errors.New("salesforce: daily API budget of the client exceeded")
+
+/*
+ *	RateLimit
+ *	Limits the rate of API requests made by a client, so a runaway loop cannot consume
+ *	the daily API allocation of the whole org. Every HTTP request counts, including retries.
+ *	@since	2.0.0
+ */
+type RateLimit struct {
+	// Maximum number of requests in any 24 hours; 0 means unlimited.
+	// Calls beyond the budget fail with ErrApiBudgetExceeded.
+	PerDay int
+
+	// Sustained number of requests per second; 0 means unlimited.
+	// Calls beyond the rate wait for their turn.
+	PerSecond float64
+
+	// Number of requests that may be made at once above PerSecond. Defaults to 1.
+	Burst int
+}
+
+/*
+ *	WithRateLimit
+ *	Limits the rate of API requests made by the client.
+ *	@since	2.0.0
+ */
+func WithRateLimit(limit RateLimit) Option {
+
+	return func(c *Client) {
+		c.dailyBudget = nil
+		c.rateLimiter = nil
+
+		if limit.PerDay > 0 {
+			c.dailyBudget = newTokenBucket(float64(limit.PerDay), float64(limit.PerDay)/(24*time.Hour).Seconds())
+		}
+
+		if limit.PerSecond > 0 {
+			c.rateLimiter = newTokenBucket(float64(max(limit.Burst, 1)), limit.PerSecond)
+		}
+	}
+
+}
+
+/*
+ *	waitForRateLimit
+ *	Waits until the rate limit of the client allows another request.
+ *	@since	2.0.0
+ */
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.dailyBudget != nil && !c.dailyBudget.take() {
+		return ErrApiBudgetExceeded
+	}
+
+	return nil
+
+}
+
+/*
+ *	tokenBucket
+ *	A token bucket refilled continuously at a fixed rate.
+ *	@since	2.0.0
+ */
+type tokenBucket struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	rate     float64
+	last     time.Time
+}
+
+/*
+ *	newTokenBucket
+ *	Returns a full bucket of the given capacity, refilled at rate tokens per second.
+ *	@since	2.0.0
+ */
+func newTokenBucket(capacity float64, rate float64) *tokenBucket {
+
+	return &tokenBucket{
+		capacity: capacity,
+		tokens:   capacity,
+		rate:     rate,
+		last:     time.Now(),
+	}
+
+}
+
+/*
+ *	refill
+ *	Adds the tokens accumulated since the last refill. The caller must hold mu.
+ *	@since	2.0.0
+ */
+func (b *tokenBucket) refill() {
+
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+}
+
+/*
+ *	take
+ *	Takes a token, if one is available.
+ *	@since	2.0.0
+ */
+func (b *tokenBucket) take() bool {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+
+}
+
+/*
+ *	wait
+ *	Takes a token, waiting for one to become available if needed.
+ *	@since	2.0.0
+ */
+func (b *tokenBucket) wait(ctx context.Context) error {
+
+	b.mu.Lock()
+	b.refill()
+	b.tokens--
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+
+}
@@ -0,0 +1,90 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+
+	"github.com/hannjosh/salesforce-go/soql"
+)
+
+/*
+ *	RecordAccess
+ *	The access of a user to a record, as determined by sharing, role hierarchy, and permissions.
+ *	@since	2.0.0
+ */
+type RecordAccess struct {
+	RecordId          string `sf:"RecordId"`
+	HasReadAccess     bool   `sf:"HasReadAccess"`
+	HasEditAccess     bool   `sf:"HasEditAccess"`
+	HasDeleteAccess   bool   `sf:"HasDeleteAccess"`
+	HasTransferAccess bool   `sf:"HasTransferAccess"`
+	HasAllAccess      bool   `sf:"HasAllAccess"`
+
+	// None, Read, Edit, Delete, Transfer, or All.
+	MaxAccessLevel string `sf:"MaxAccessLevel"`
+}
+
+/*
+ *	UserRecordAccess
+ *	Returns the access of a user to up to 200 records, keyed by their 18-character IDs, so that services acting on behalf
+ *	of the user can enforce Salesforce sharing. Records the user cannot see are reported without access.
+ *	@since	2.0.0
+ */
+func (c *Client) UserRecordAccess(ctx context.Context, userId string, recordIds ...string) (map[string]RecordAccess, error) {
+
+	statement, err := soql.Format(`SELECT RecordId, HasReadAccess, HasEditAccess, HasDeleteAccess, HasTransferAccess, HasAllAccess, MaxAccessLevel
+		FROM UserRecordAccess WHERE UserId = ? AND RecordId IN ?`, userId, recordIds)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := QueryInto[RecordAccess](ctx, c, statement)
+	if err != nil {
+		return nil, err
+	}
+
+	access := make(map[string]RecordAccess, len(recordIds))
+	for _, id := range recordIds {
+		access[id] = RecordAccess{RecordId: id, MaxAccessLevel: "None"}
+	}
+
+	for _, record := range records {
+		access[record.RecordId] = record
+	}
+
+	return access, nil
+
+}
+
+/*
+ *	CanEdit
+ *	Reports whether a user can edit a record.
+ *	@since	2.0.0
+ */
+func (c *Client) CanEdit(ctx context.Context, userId string, recordId string) (bool, error) {
+
+	access, err := c.UserRecordAccess(ctx, userId, recordId)
+	if err != nil {
+		return false, err
+	}
+
+	return access[recordId].HasEditAccess, nil
+
+}
@@ -0,0 +1,190 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+ *	ErrRecordTypeNotFound
+ *	Returned when an object has no record type with the given developer name.
+ *	@since	2.0.0
+ */
+var ErrRecordTypeNotFound = errors.New("salesforce: record type not found")
+
+/*
+ *	recordTypeKey
+ *	Context key of the developer names of the record types given to created records, keyed by lower-case object name.
+ *	@since	2.0.0
+ */
+type recordTypeKey struct{}
+
+/*
+ *	ContextWithRecordType
+ *	Returns a context that gives records of an object, e.g. Account, created or upserted with it
+ *	the record type with the given developer name, unless they already set RecordTypeId.
+ *	Records of other objects are not affected; record types set by enclosing contexts for them are kept.
+ *	@since	2.0.0
+ */
+func ContextWithRecordType(ctx context.Context, object string, developerName string) context.Context {
+
+	parent, _ := ctx.Value(recordTypeKey{}).(map[string]string)
+
+	recordTypes := make(map[string]string, len(parent)+1)
+	for key, name := range parent {
+		recordTypes[key] = name
+	}
+	recordTypes[strings.ToLower(object)] = developerName
+
+	return context.WithValue(ctx, recordTypeKey{}, recordTypes)
+
+}
+
+/*
+ *	recordTypeFor
+ *	Returns the developer name of the record type set by the context for an object, or "" if there is none.
+ *	@since	2.0.0
+ */
+func recordTypeFor(ctx context.Context, object string) string {
+
+	recordTypes, _ := ctx.Value(recordTypeKey{}).(map[string]string)
+
+	return recordTypes[strings.ToLower(object)]
+
+}
+
+/*
+ *	RecordTypeIds
+ *	Returns the IDs of the record types of an object available to the user, keyed by developer name.
+ *	The IDs are cached by the client after the first call for each object.
+ *	@since	2.0.0
+ */
+func (c *Client) RecordTypeIds(ctx context.Context, object string) (map[string]string, error) {
+
+	key := strings.ToLower(object)
+
+	c.recordTypesMu.Lock()
+	ids, ok := c.recordTypes[key]
+	c.recordTypesMu.Unlock()
+
+	if ok {
+		return ids, nil
+	}
+
+	describe, err := c.DescribeSObject(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	ids = make(map[string]string, len(describe.RecordTypeInfos))
+	for _, info := range describe.RecordTypeInfos {
+		ids[info.DeveloperName] = info.RecordTypeId
+	}
+
+	c.recordTypesMu.Lock()
+	if c.recordTypes == nil {
+		c.recordTypes = make(map[string]map[string]string)
+	}
+	c.recordTypes[key] = ids
+	c.recordTypesMu.Unlock()
+
+	return ids, nil
+
+}
+
+/*
+ *	RecordTypeId
+ *	Returns the ID of the record type of an object with the given developer name.
+ *	@since	2.0.0
+ */
+func (c *Client) RecordTypeId(ctx context.Context, object string, developerName string) (string, error) {
+
+	ids, err := c.RecordTypeIds(ctx, object)
+	if err != nil {
+		return "", err
+	}
+
+	id, ok := ids[developerName]
+	if !ok {
+		return "", fmt.Errorf("%w: %s.%s", ErrRecordTypeNotFound, object, developerName)
+	}
+
+	return id, nil
+
+}
+
+/*
+ *	withRecordType
+ *	Returns the record with the RecordTypeId of the record type set by the context for the object, if any.
+ *	The record is copied rather than modified.
+ *	@since	2.0.0
+ */
+func (c *Client) withRecordType(ctx context.Context, object string, record map[string]interface{}) (map[string]interface{}, error) {
+
+	developerName := recordTypeFor(ctx, object)
+	if developerName == "" {
+		return record, nil
+	}
+
+	if _, ok := record["RecordTypeId"]; ok {
+		return record, nil
+	}
+
+	id, err := c.RecordTypeId(ctx, object, developerName)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make(map[string]interface{}, len(record)+1)
+	for field, value := range record {
+		typed[field] = value
+	}
+	typed["RecordTypeId"] = id
+
+	return typed, nil
+
+}
+
+/*
+ *	withRecordTypes
+ *	Returns the records with the RecordTypeId of the record type set by the context for the object, if any.
+ *	@since	2.0.0
+ */
+func (c *Client) withRecordTypes(ctx context.Context, object string, records []map[string]interface{}) ([]map[string]interface{}, error) {
+
+	if recordTypeFor(ctx, object) == "" {
+		return records, nil
+	}
+
+	typed := make([]map[string]interface{}, len(records))
+
+	for i, record := range records {
+		var err error
+		if typed[i], err = c.withRecordType(ctx, object, record); err != nil {
+			return nil, err
+		}
+	}
+
+	return typed, nil
+
+}
@@ -0,0 +1,83 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+)
+
+/*
+ *	TestContextWithRecordTypeIsScopedToObject
+ *	Records of other objects created with the same context must not be given the record type.
+ *	@since	2.0.0
+ */
+func TestContextWithRecordTypeIsScopedToObject(t *testing.T) {
+
+	created := make(map[string]map[string]interface{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/sobjects/Account/describe/") {
+			io.WriteString(w, `{"name": "Account", "recordTypeInfos": [{"developerName": "Partner", "recordTypeId": "012000000000001AAA"}]}`)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+
+		var record map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&record)
+		created[path.Base(r.URL.Path)] = record
+
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": "001000000000001AAA", "success": true, "errors": []}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithInstanceUrl(server.URL), WithOAuth2AccessToken("Bearer token"))
+	ctx := ContextWithRecordType(context.Background(), "account", "Partner")
+
+	if _, err := client.Create(ctx, "Account", map[string]interface{}{"Name": "Acme"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Create(ctx, "Contact", map[string]interface{}{"LastName": "Smith"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := created["Account"]["RecordTypeId"]; got != "012000000000001AAA" {
+		t.Errorf("Account RecordTypeId = %v, want 012000000000001AAA", got)
+	}
+
+	if got, ok := created["Contact"]["RecordTypeId"]; ok {
+		t.Errorf("Contact RecordTypeId = %v, want none", got)
+	}
+
+}
@@ -0,0 +1,88 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/xml"
+)
+
+/*
+ *	Undelete
+ *	Restores up to 200 deleted records, of any objects, from the Recycle Bin, through the SOAP API.
+ *	Records remain in the Recycle Bin for 15 days after deletion, unless it is emptied.
+ *	The results are returned in the order of the IDs.
+ *	@since	2.0.0
+ */
+func (c *Client) Undelete(ctx context.Context, ids ...string) ([]SaveResult, error) {
+
+	operation := struct {
+		XMLName xml.Name `xml:"urn:partner.soap.sforce.com undelete"`
+		Ids     []string `xml:"ids"`
+	}{Ids: ids}
+
+	var results []soapResult
+
+	if err := c.soapCall(ctx, operation, &results); err != nil {
+		return nil, err
+	}
+
+	return saveResults(results), nil
+
+}
+
+/*
+ *	EmptyRecycleBin
+ *	Permanently deletes up to 200 records, of any objects, from the Recycle Bin, through the SOAP API,
+ *	e.g. to erase personal data. Records must be deleted before they can be purged, and cannot be restored afterwards.
+ *	The results are returned in the order of the IDs.
+ *	@since	2.0.0
+ */
+func (c *Client) EmptyRecycleBin(ctx context.Context, ids ...string) ([]SaveResult, error) {
+
+	operation := struct {
+		XMLName xml.Name `xml:"urn:partner.soap.sforce.com emptyRecycleBin"`
+		Ids     []string `xml:"ids"`
+	}{Ids: ids}
+
+	var results []soapResult
+
+	if err := c.soapCall(ctx, operation, &results); err != nil {
+		return nil, err
+	}
+
+	return saveResults(results), nil
+
+}
+
+/*
+ *	saveResults
+ *	Converts the results of a SOAP API call to SaveResults.
+ *	@since	2.0.0
+ */
+func saveResults(results []soapResult) []SaveResult {
+
+	saveResults := make([]SaveResult, len(results))
+	for i := range results {
+		saveResults[i] = results[i].saveResult()
+	}
+
+	return saveResults
+
+}
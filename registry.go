@@ -0,0 +1,242 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+/*
+ *	ErrClientNotFound
+ *	Returned when a registry has no client with the given name or alias.
+ *	@since	2.0.0
+ */
+var ErrClientNotFound = errors.New("salesforce: client not found")
+
+/*
+ *	Registry
+ *	A set of named clients for several orgs, e.g. production, sandboxes, and partner orgs,
+ *	each with its own credentials, API version, retry policy, and rate limits.
+ *	A Registry is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
+ */
+type Registry struct {
+	// Options applied to every client created by Add, before its own.
+	defaults []Option
+
+	// Guards the fields below.
+	mu sync.RWMutex
+
+	// Clients by name, and the names of clients by alias.
+	clients map[string]*Client
+	aliases map[string]string
+}
+
+/*
+ *	NewRegistry
+ *	Returns an empty registry whose clients are created with the given default options.
+ *	@since	2.0.0
+ */
+func NewRegistry(defaults ...Option) *Registry {
+
+	return &Registry{
+		defaults: defaults,
+		clients:  make(map[string]*Client),
+		aliases:  make(map[string]string),
+	}
+
+}
+
+/*
+ *	Add
+ *	Creates a client with the default options of the registry followed by the given ones, and registers it by name.
+ *	The client must still be authenticated, unless it was given an access token.
+ *	@since	2.0.0
+ */
+func (r *Registry) Add(name string, opts ...Option) (*Client, error) {
+
+	client := NewClient(append(append([]Option(nil), r.defaults...), opts...)...)
+
+	if err := r.Register(name, client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+
+}
+
+/*
+ *	Register
+ *	Registers an existing client by name.
+ *	@since	2.0.0
+ */
+func (r *Registry) Register(name string, client *Client) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkName(name); err != nil {
+		return err
+	}
+
+	r.clients[name] = client
+	return nil
+
+}
+
+/*
+ *	Alias
+ *	Adds an alias for a registered client, e.g. "prod" for "acme-production".
+ *	@since	2.0.0
+ */
+func (r *Registry) Alias(alias string, name string) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clients[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrClientNotFound, name)
+	}
+
+	if err := r.checkName(alias); err != nil {
+		return err
+	}
+
+	r.aliases[alias] = name
+	return nil
+
+}
+
+/*
+ *	checkName
+ *	Reports an error if a name is empty or already used by a client or alias. The caller must hold mu.
+ *	@since	2.0.0
+ */
+func (r *Registry) checkName(name string) error {
+
+	if name == "" {
+		return errors.New("salesforce: empty client name")
+	}
+
+	if _, ok := r.clients[name]; ok {
+		return errors.New("salesforce: client already registered: " + name)
+	}
+
+	if _, ok := r.aliases[name]; ok {
+		return errors.New("salesforce: client alias already registered: " + name)
+	}
+
+	return nil
+
+}
+
+/*
+ *	Client
+ *	Returns the client with the given name or alias.
+ *	@since	2.0.0
+ */
+func (r *Registry) Client(nameOrAlias string) (*Client, error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	name := nameOrAlias
+	if aliased, ok := r.aliases[nameOrAlias]; ok {
+		name = aliased
+	}
+
+	client, ok := r.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrClientNotFound, nameOrAlias)
+	}
+
+	return client, nil
+
+}
+
+/*
+ *	Remove
+ *	Removes a client, by name or alias, and all of its aliases.
+ *	@since	2.0.0
+ */
+func (r *Registry) Remove(nameOrAlias string) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := nameOrAlias
+	if aliased, ok := r.aliases[nameOrAlias]; ok {
+		name = aliased
+	}
+
+	delete(r.clients, name)
+
+	for alias, aliased := range r.aliases {
+		if aliased == name {
+			delete(r.aliases, alias)
+		}
+	}
+
+}
+
+/*
+ *	Names
+ *	Returns the names of the registered clients, sorted.
+ *	@since	2.0.0
+ */
+func (r *Registry) Names() []string {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.clients))
+	for name := range r.clients {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+
+}
+
+/*
+ *	Each
+ *	Calls fn with each registered client in the order of their names, until fn returns an error.
+ *	Clients added or removed meanwhile may or may not be visited.
+ *	@since	2.0.0
+ */
+func (r *Registry) Each(fn func(name string, client *Client) error) error {
+
+	for _, name := range r.Names() {
+		client, err := r.Client(name)
+		if errors.Is(err, ErrClientNotFound) {
+			continue
+		}
+
+		if err := fn(name, client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
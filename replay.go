@@ -0,0 +1,226 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+/*
+ *	ReplayStore
+ *	Stores the replay ID of the last event processed on each channel, so subscribers resume after it when restarted.
+ *	Replay IDs are opaque bytes: the Pub/Sub API uses binary IDs, and the Streaming API decimal numbers.
+ *	Implementations must be safe for concurrent use.
+ *	@since	2.0.0
+ */
+type ReplayStore interface {
+	// Returns the replay ID stored for a channel, or nil if there is none.
+	Get(ctx context.Context, channel string) ([]byte, error)
+
+	// Stores the replay ID for a channel.
+	Set(ctx context.Context, channel string, replayId []byte) error
+}
+
+/*
+ *	MemoryReplayStore
+ *	A ReplayStore held in memory, which resumes subscriptions after reconnections but not after restarts.
+ *	@since	2.0.0
+ */
+type MemoryReplayStore struct {
+	mu        sync.RWMutex
+	replayIds map[string][]byte
+}
+
+/*
+ *	NewMemoryReplayStore
+ *	Returns an empty ReplayStore held in memory.
+ *	@since	2.0.0
+ */
+func NewMemoryReplayStore() *MemoryReplayStore {
+
+	return &MemoryReplayStore{replayIds: make(map[string][]byte)}
+
+}
+
+/*
+ *	Get
+ *	Returns the replay ID stored for a channel, or nil if there is none.
+ *	@since	2.0.0
+ */
+func (m *MemoryReplayStore) Get(ctx context.Context, channel string) ([]byte, error) {
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.replayIds[channel], nil
+
+}
+
+/*
+ *	Set
+ *	Stores the replay ID for a channel.
+ *	@since	2.0.0
+ */
+func (m *MemoryReplayStore) Set(ctx context.Context, channel string, replayId []byte) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.replayIds[channel] = append([]byte(nil), replayId...)
+
+	return nil
+
+}
+
+/*
+ *	FileReplayStore
+ *	A ReplayStore persisted to a JSON file, rewritten atomically on every update.
+ *	@since	2.0.0
+ */
+type FileReplayStore struct {
+	path string
+
+	// Guards replayIds, loaded from the file when first needed.
+	mu        sync.Mutex
+	replayIds map[string][]byte
+}
+
+/*
+ *	NewFileReplayStore
+ *	Returns a ReplayStore persisted to the file at path, which is created when the first replay ID is stored.
+ *	@since	2.0.0
+ */
+func NewFileReplayStore(path string) *FileReplayStore {
+
+	return &FileReplayStore{path: path}
+
+}
+
+/*
+ *	load
+ *	Reads the file, unless it has been read already. Must be called with mu held.
+ *	@since	2.0.0
+ */
+func (f *FileReplayStore) load() error {
+
+	if f.replayIds != nil {
+		return nil
+	}
+
+	replayIds := make(map[string][]byte)
+
+	data, err := os.ReadFile(f.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &replayIds); err != nil {
+			return err
+		}
+	}
+
+	f.replayIds = replayIds
+
+	return nil
+
+}
+
+/*
+ *	Get
+ *	Returns the replay ID stored for a channel, or nil if there is none.
+ *	@since	2.0.0
+ */
+func (f *FileReplayStore) Get(ctx context.Context, channel string) ([]byte, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+
+	return f.replayIds[channel], nil
+
+}
+
+/*
+ *	Set
+ *	Stores the replay ID for a channel and rewrites the file.
+ *	@since	2.0.0
+ */
+func (f *FileReplayStore) Set(ctx context.Context, channel string, replayId []byte) error {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.load(); err != nil {
+		return err
+	}
+
+	f.replayIds[channel] = append([]byte(nil), replayId...)
+
+	data, err := json.Marshal(f.replayIds)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file, flush it to disk and rename it, so a crash never leaves a partial file.
+	temp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+
+	if err := temp.Sync(); err != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+
+	if err := temp.Close(); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+
+	if err := os.Rename(temp.Name(), f.path); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+
+	// Flush the rename too; directories cannot be synced on every platform, so this is best-effort.
+	if dir, err := os.Open(filepath.Dir(f.path)); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+
+	return nil
+
+}
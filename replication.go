@@ -0,0 +1,113 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+/*
+ *	UpdatedRecords
+ *	The IDs of the records of an object updated within a time window.
+ *	@since	2.0.0
+ */
+type UpdatedRecords struct {
+	Ids []string `json:"ids"`
+
+	// Last date covered by the call; start the next window from it.
+	LatestDateCovered string `json:"latestDateCovered"`
+}
+
+/*
+ *	DeletedRecords
+ *	The records of an object deleted within a time window.
+ *	@since	2.0.0
+ */
+type DeletedRecords struct {
+	DeletedRecords []DeletedRecord `json:"deletedRecords"`
+
+	// Earliest date for which deleted records are still available, about 15 days ago.
+	EarliestDateAvailable string `json:"earliestDateAvailable"`
+
+	// Last date covered by the call; start the next window from it.
+	LatestDateCovered string `json:"latestDateCovered"`
+}
+
+/*
+ *	DeletedRecord
+ *	@since	2.0.0
+ */
+type DeletedRecord struct {
+	Id          string `json:"id"`
+	DeletedDate string `json:"deletedDate"`
+}
+
+/*
+ *	GetUpdated
+ *	Returns the IDs of the records of an object updated between start and end, to the minute,
+ *	for incremental replication. The window must start within the last 30 days.
+ *	@since	2.0.0
+ */
+func (c *Client) GetUpdated(ctx context.Context, object string, start time.Time, end time.Time) (*UpdatedRecords, error) {
+
+	var records UpdatedRecords
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "sobjects/"+object+"/updated/?"+replicationWindow(start, end)), nil, &records); err != nil {
+		return nil, err
+	}
+
+	return &records, nil
+
+}
+
+/*
+ *	GetDeleted
+ *	Returns the records of an object deleted between start and end, to the minute,
+ *	for incremental replication. The window must start within the last 15 days.
+ *	@since	2.0.0
+ */
+func (c *Client) GetDeleted(ctx context.Context, object string, start time.Time, end time.Time) (*DeletedRecords, error) {
+
+	var records DeletedRecords
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "sobjects/"+object+"/deleted/?"+replicationWindow(start, end)), nil, &records); err != nil {
+		return nil, err
+	}
+
+	return &records, nil
+
+}
+
+/*
+ *	replicationWindow
+ *	Encodes the query parameters of a time window, in UTC.
+ *	@since	2.0.0
+ */
+func replicationWindow(start time.Time, end time.Time) string {
+
+	query := url.Values{}
+	query.Set("start", start.UTC().Format(time.RFC3339))
+	query.Set("end", end.UTC().Format(time.RFC3339))
+
+	return query.Encode()
+
+}
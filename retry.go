@@ -0,0 +1,223 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+/*
+ *	RetryPolicy
+ *	Controls how the client retries requests that fail transiently:
+ *	network errors, 5xx responses, 429 Too Many Requests,
+ *	and the REQUEST_LIMIT_EXCEEDED and SERVER_UNAVAILABLE error codes.
+ *	The delay between attempts grows exponentially with full jitter,
+ *	unless Salesforce asks for a specific delay with a Retry-After header.
+ *	Requests whose body cannot be replayed are never retried.
+ *	@since	2.0.0
+ */
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first. 0 or 1 disables retries.
+	MaxAttempts int
+
+	// Upper bound of the delay before the first retry. Defaults to 500ms.
+	InitialBackoff time.Duration
+
+	// Upper bound of the delay before any retry. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+/*
+ *	WithRetry
+ *	Makes the client retry transient failures according to the policy.
+ *	Note that a create retried after a network error may create the record twice.
+ *	@since	2.0.0
+ */
+func WithRetry(policy RetryPolicy) Option {
+
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+
+}
+
+/*
+ *	backoff
+ *	Returns the delay before the given retry attempt.
+ *	@since	2.0.0
+ */
+func (p RetryPolicy) backoff(attempt int, response *http.Response) time.Duration {
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+
+	if response != nil {
+		if delay, ok := retryAfter(response.Header.Get("Retry-After")); ok {
+			return min(delay, maxBackoff)
+		}
+	}
+
+	initialBackoff := p.InitialBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = 500 * time.Millisecond
+	}
+
+	ceiling := maxBackoff
+	if attempt < 32 {
+		ceiling = min(initialBackoff<<(attempt-1), maxBackoff)
+	}
+
+	return rand.N(ceiling) + 1
+
+}
+
+/*
+ *	Backoff
+ *	Returns the delay before the given retry attempt, counted from 1,
+ *	for callers retrying operations of their own, e.g. reconnections.
+ *	@since	2.0.0
+ */
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+
+	return p.backoff(max(attempt, 1), nil)
+
+}
+
+/*
+ *	retryAfter
+ *	Parses a Retry-After header, in seconds or as an HTTP date.
+ *	@since	2.0.0
+ */
+func retryAfter(header string) (time.Duration, bool) {
+
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+
+}
+
+/*
+ *	isRetryable
+ *	Reports whether a request failed transiently.
+ *	Errors are only retried if they come from the network; errors renewing the access token,
+ *	of middleware, or of the client itself, e.g. ErrApiBudgetExceeded, are returned at once.
+ *	@since	2.0.0
+ */
+func isRetryable(request *http.Request, response *http.Response, err error) bool {
+
+	if err != nil {
+		return request.Context().Err() == nil && isTransportError(err)
+	}
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		return true
+	case response.StatusCode == http.StatusInternalServerError && strings.HasPrefix(response.Header.Get("Content-Type"), "text/xml"):
+		// SOAP faults are returned with 500 Internal Server Error, but are not transient.
+		return false
+	case response.StatusCode >= 500:
+		return true
+	case response.StatusCode == http.StatusForbidden:
+		return hasErrorCode(response, "REQUEST_LIMIT_EXCEEDED")
+	}
+
+	return false
+
+}
+
+/*
+ *	isTransportError
+ *	Reports whether an error was raised sending a request or reading its response over the network,
+ *	e.g. a timeout, a refused or reset connection, or a connection closed before the response was complete.
+ *	@since	2.0.0
+ */
+func isTransportError(err error) bool {
+
+	// The HTTP client wraps every error, including those of the transport, in a *url.Error.
+	var urlError *url.Error
+	if errors.As(err, &urlError) {
+		err = urlError.Err
+	}
+
+	var netError net.Error
+	if errors.As(err, &netError) {
+		return true
+	}
+
+	return errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE)
+
+}
+
+/*
+ *	hasErrorCode
+ *	Reports whether an error response carries the given Salesforce error code.
+ *	The response body is preserved for later reading.
+ *	@since	2.0.0
+ */
+func hasErrorCode(response *http.Response, errorCode string) bool {
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return false
+	}
+
+	var errs []SalesforceError
+	if json.Unmarshal(body, &errs) != nil {
+		return false
+	}
+
+	for _, e := range errs {
+		if e.ErrorCode == errorCode {
+			return true
+		}
+	}
+
+	return false
+
+}
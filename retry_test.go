@@ -0,0 +1,144 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+ *	TestRenewalFailureIsNotRetried
+ *	A rejected refresh token is permanent, so the call must fail after a single token request.
+ *	@since	2.0.0
+ */
+func TestRenewalFailureIsNotRetried(t *testing.T) {
+
+	var tokenRequests, apiRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/oauth2/token" {
+			tokenRequests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error": "invalid_grant", "error_description": "expired access/refresh token"}`)
+			return
+		}
+
+		apiRequests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `[{"errorCode": "INVALID_SESSION_ID", "message": "Session expired or invalid"}]`)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithInstanceUrl(server.URL),
+		WithLoginUrl(server.URL),
+		WithOAuth2AccessToken("Bearer stale"),
+		WithOAuth2Client("id", "secret"),
+		WithRefreshToken("refresh"),
+		WithRetry(RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Millisecond}),
+	)
+
+	err := client.DoJSON(context.Background(), http.MethodGet, "limits", nil, nil)
+
+	var oauth2Error *OAuth2Error
+	if !errors.As(err, &oauth2Error) {
+		t.Fatalf("error = %v, want an *OAuth2Error", err)
+	}
+
+	if n := tokenRequests.Load(); n != 1 {
+		t.Errorf("%d token requests, want 1", n)
+	}
+
+	if n := apiRequests.Load(); n != 1 {
+		t.Errorf("%d API requests, want 1", n)
+	}
+
+}
+
+/*
+ *	TestTransportErrorIsRetried
+ *	@since	2.0.0
+ */
+func TestTransportErrorIsRetried(t *testing.T) {
+
+	var attempts atomic.Int32
+
+	transport := RoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		if attempts.Add(1) < 3 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(http.NoBody)}, nil
+	})
+
+	client := NewClient(
+		WithInstanceUrl("https://example.my.salesforce.com"),
+		WithOAuth2AccessToken("Bearer token"),
+		WithTransport(transport),
+		WithRetry(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+	)
+
+	if err := client.DoJSON(context.Background(), http.MethodGet, "limits", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("%d attempts, want 3", n)
+	}
+
+}
+
+/*
+ *	TestMiddlewareErrorIsNotRetried
+ *	@since	2.0.0
+ */
+func TestMiddlewareErrorIsNotRetried(t *testing.T) {
+
+	var attempts atomic.Int32
+	refused := errors.New("refused by middleware")
+
+	client := NewClient(
+		WithInstanceUrl("https://example.my.salesforce.com"),
+		WithOAuth2AccessToken("Bearer token"),
+		WithMiddleware(func(next RoundTripperFunc) RoundTripperFunc {
+			return func(request *http.Request) (*http.Response, error) {
+				attempts.Add(1)
+				return nil, refused
+			}
+		}),
+		WithRetry(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+	)
+
+	if err := client.DoJSON(context.Background(), http.MethodGet, "limits", nil, nil); !errors.Is(err, refused) {
+		t.Fatalf("error = %v, want the middleware error", err)
+	}
+
+	if n := attempts.Load(); n != 1 {
+		t.Errorf("%d attempts, want 1", n)
+	}
+
+}
@@ -20,140 +20,1016 @@ package salesforce
 // Import standard packages.
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 )
 
 /*
- *	Version of the Salesforce REST API to use.
+ *	Version of the Salesforce REST API used when a client does not specify one.
  *	@since	1.0.0
  */
 const ApiVersion string = "v61.0"
 
 /*
- *	My Domain
- *	The subdomain of the Salesforce org.
- *	@since	1.0.0
+ *	Client
+ *	A client for a single Salesforce org.
+ *	A Client is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
  */
-var MyDomain string
+type Client struct {
+	// My Domain: the subdomain of the Salesforce org.
+	domain string
+
+	// Name of the sandbox, if the org is a sandbox.
+	sandbox string
+
+	// Whether the org is a scratch org.
+	scratch bool
+
+	// URL of the OAuth 2.0 endpoints, if not the My Domain URL.
+	loginUrl string
+
+	// Whether to use the newest API version supported by the org,
+	// and the function called with the outcome of the negotiation, if any.
+	latestApiVersion  bool
+	negotiateCallback func(version string, err error)
+
+	// Serialises API version negotiation.
+	negotiateMu sync.Mutex
+
+	// HTTP client used to send requests.
+	httpClient *http.Client
+
+	// Transport and timeout overriding those of the HTTP client, if set.
+	transport http.RoundTripper
+	timeout   time.Duration
+
+	// Headers added to every request.
+	header http.Header
+
+	// Whether to compress requests and responses with gzip, and the minimum size of compressed bodies.
+	compression        bool
+	compressionMinSize int
+
+	// Middleware wrapping the HTTP client, and the resulting function sending requests.
+	middleware []Middleware
+	handler    RoundTripperFunc
+
+	// Retry policy for transient failures.
+	retryPolicy RetryPolicy
+
+	// Called with the API usage reported by every response.
+	apiUsageCallback func(ApiUsage)
+
+	// Client-side limits of the rate of API requests, if any.
+	rateLimiter *tokenBucket
+	dailyBudget *tokenBucket
+
+	// Cache of describe results, if any.
+	describeCache DescribeCache
+
+	// Whether records rejected by duplicate rules allowing saves are sent again, overriding the rules.
+	overrideDuplicates bool
+
+	// Whether the identity of the authenticated user is resolved whenever an access token is obtained,
+	// and the function called with the outcome, if any.
+	resolveIdentity  bool
+	identityCallback func(identity *Identity, err error)
+
+	// Guards recordTypes, the IDs of the record types of each object, keyed by developer name.
+	recordTypesMu sync.Mutex
+	recordTypes   map[string]map[string]string
+
+	// Consumer key and secret of the connected app, used to renew the access token.
+	clientId     string
+	clientSecret string
+
+	// Guards renewal, the token renewal in flight, if any.
+	renewMu sync.Mutex
+	renewal *renewal
+
+	// Guards the fields below.
+	mu sync.RWMutex
+
+	// Version of the Salesforce REST API to use.
+	apiVersion string
+
+	// Whether negotiation of the API version has been attempted, successfully or not.
+	negotiateAttempted bool
+
+	// OAuth 2.0 access token used to authorise the client, including the token type.
+	// The Salesforce REST API supports the Bearer authentication type.
+	token string
+
+	// OAuth 2.0 refresh token used to renew the access token.
+	refreshToken string
+
+	// Repeats the OAuth 2.0 flow that obtained the access token, if it can be repeated.
+	reauthenticate func(ctx context.Context) error
+
+	// Identity URL of the authenticated user, returned with the access token.
+	identityUrl string
+
+	// Identity of the authenticated user, resolved from the identity URL, if it has been.
+	identity *Identity
+
+	// URL of the instance serving the org, returned with the access token.
+	instanceUrl string
+
+	// API usage reported by the most recent response.
+	apiUsage ApiUsage
+}
 
 /*
- *	OAuth 2.0 access token used to authorise the client.
- *	The Salesforce REST API supports the Bearer authentication type.
- *	@since	1.0.0
+ *	Option
+ *	Configures a Client.
+ *	@since	2.0.0
  */
-var OAuth2AccessToken string
+type Option func(*Client)
 
 /*
- *	GetAuthorizationToken
- *	Obtains an OAuth 2.0 access token to authorise calls to the Salesforce REST API.
- *	@since	1.0.0
+ *	WithDomain
+ *	Sets the My Domain subdomain of the Salesforce org.
+ *	@since	2.0.0
  */
-func GetOAuth2AccessToken(client_id string, client_secret string) (string, error) {
+func WithDomain(domain string) Option {
 
-	data := url.Values{}
-	data.Set("grant_type", "client_credentials")
-	data.Set("client_id", client_id)
-	data.Set("client_secret", client_secret)
+	return func(c *Client) {
+		c.domain = domain
+	}
+
+}
 
-	request, _ := http.NewRequest(
-		http.MethodPost,
-		fmt.Sprintf("https://%s.my.salesforce.com/services/oauth2/token", MyDomain),
-		strings.NewReader(data.Encode()),
-	)
+/*
+ *	WithSandbox
+ *	Connects to a sandbox of the org, at {domain}--{name}.sandbox.my.salesforce.com.
+ *	@since	2.0.0
+ */
+func WithSandbox(name string) Option {
 
-	request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	return func(c *Client) {
+		c.sandbox = name
+	}
 
-	response, _ := (&http.Client{}).Do(request)
+}
 
-	var responseBody struct {
-		// OK
-		AccessToken string `json:"access_token"`
-		InstanceUrl string `json:"instance_url"`
-		Id          string `json:"id"`
-		TokenType   string `json:"token_type"`
-		Scope       string `json:"scope"`
-		IssuedAt    string `json:"issued_at"`
-		Signature   string `json:"signature"`
+/*
+ *	WithScratchOrg
+ *	Connects to a scratch org, at {domain}.scratch.my.salesforce.com.
+ *	@since	2.0.0
+ */
+func WithScratchOrg() Option {
 
-		// Error
-		Error string `json:"error"`
+	return func(c *Client) {
+		c.scratch = true
 	}
 
-	json.NewDecoder(response.Body).Decode(&responseBody)
+}
 
-	response.Body.Close()
+/*
+ *	WithLoginUrl
+ *	Sets the URL of the OAuth 2.0 endpoints, e.g. LoginUrlSandbox to log in to any sandbox
+ *	through test.salesforce.com. By default, the My Domain URL is used.
+ *	@since	2.0.0
+ */
+func WithLoginUrl(loginUrl string) Option {
 
-	if responseBody.TokenType == "" {
-		return "", errors.New(responseBody.Error)
+	return func(c *Client) {
+		c.loginUrl = strings.TrimSuffix(loginUrl, "/")
 	}
 
-	return responseBody.TokenType + " " + responseBody.AccessToken, nil
+}
+
+/*
+ *	WithInstanceUrl
+ *	Sets the URL of the instance serving the org, as returned with an access token.
+ *	It is set automatically when the client obtains its own access token.
+ *	@since	2.0.0
+ */
+func WithInstanceUrl(instanceUrl string) Option {
+
+	return func(c *Client) {
+		c.instanceUrl = strings.TrimSuffix(instanceUrl, "/")
+	}
 
 }
 
 /*
- *	Query
- *	@since	1.0.0
+ *	WithOAuth2AccessToken
+ *	Sets the OAuth 2.0 access token used to authorise the client, e.g. "Bearer 00D...".
+ *	@since	2.0.0
+ */
+func WithOAuth2AccessToken(token string) Option {
+
+	return func(c *Client) {
+		c.token = token
+	}
+
+}
+
+/*
+ *	WithApiVersion
+ *	Sets the version of the Salesforce REST API to use, e.g. "v61.0".
+ *	@since	2.0.0
+ */
+func WithApiVersion(version string) Option {
+
+	return func(c *Client) {
+		c.apiVersion = version
+	}
+
+}
+
+/*
+ *	WithHttpClient
+ *	Sets the HTTP client used to send requests, e.g. one configured with a proxy or custom TLS settings.
+ *	By default, a client using http.DefaultTransport is used.
+ *	@since	2.0.0
+ */
+func WithHttpClient(httpClient *http.Client) Option {
+
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+
+}
+
+/*
+ *	WithHeader
+ *	Adds a header to every request sent by the client.
+ *	Headers added with ContextWithHeaders take precedence.
+ *	@since	2.0.0
+ */
+func WithHeader(key string, value string) Option {
+
+	return func(c *Client) {
+		c.header.Set(key, value)
+	}
+
+}
+
+/*
+ *	WithCallOptions
+ *	Sets the Sforce-Call-Options header of every request: the client name recorded in Event Monitoring,
+ *	and the namespace of a managed package whose fields can then be referenced without their prefix.
+ *	Either may be empty.
+ *	@since	2.0.0
+ */
+func WithCallOptions(client string, defaultNamespace string) Option {
+
+	var options []string
+
+	if client != "" {
+		options = append(options, "client="+client)
+	}
+
+	if defaultNamespace != "" {
+		options = append(options, "defaultNamespace="+defaultNamespace)
+	}
+
+	return WithHeader("Sforce-Call-Options", strings.Join(options, ", "))
+
+}
+
+/*
+ *	WithLocale
+ *	Sets the Accept-Language header of every request, e.g. "fr-FR", so labels and
+ *	error messages are returned in the given language where the org supports it.
+ *	@since	2.0.0
  */
-func Query(soql string) []byte {
+func WithLocale(locale string) Option {
 
-	request, _ := http.NewRequest(
-		http.MethodGet,
-		fmt.Sprintf("https://%s.my.salesforce.com/services/data/%s/query/?q=%s", MyDomain, ApiVersion, url.QueryEscape(soql)),
-		nil,
-	)
+	return WithHeader("Accept-Language", locale)
+
+}
+
+/*
+ *	WithPackageVersion
+ *	Pins the behavior of an installed managed package to the given version, e.g. "1.2",
+ *	for every request sent by the client. May be repeated for several namespaces.
+ *	@since	2.0.0
+ */
+func WithPackageVersion(namespace string, version string) Option {
+
+	return WithHeader(packageVersionHeader(namespace), version)
+
+}
+
+/*
+ *	packageVersionHeader
+ *	Returns the name of the header pinning the version of a managed package.
+ *	@since	2.0.0
+ */
+func packageVersionHeader(namespace string) string {
+
+	return "x-sfdc-packageversion-" + namespace
+
+}
+
+/*
+ *	WithTransport
+ *	Sets the RoundTripper used to send requests, e.g. for proxies, connection pooling,
+ *	or corporate TLS interception. It replaces the transport of the HTTP client.
+ *	@since	2.0.0
+ */
+func WithTransport(transport http.RoundTripper) Option {
+
+	return func(c *Client) {
+		c.transport = transport
+	}
+
+}
+
+/*
+ *	WithTimeout
+ *	Sets the time limit of each request, including reading the response body.
+ *	It replaces the timeout of the HTTP client. Long downloads, such as Bulk API results,
+ *	may need a generous limit; prefer context deadlines for per-call limits.
+ *	@since	2.0.0
+ */
+func WithTimeout(timeout time.Duration) Option {
+
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+
+}
+
+/*
+ *	NewClient
+ *	Returns a new Client configured with the given options.
+ *	@since	2.0.0
+ */
+func NewClient(opts ...Option) *Client {
+
+	c := &Client{
+		apiVersion: ApiVersion,
+		httpClient: &http.Client{},
+		header:     make(http.Header),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	// Never modify the HTTP client given by the caller.
+	if c.transport != nil || c.timeout != 0 {
+		httpClient := *c.httpClient
+		if c.transport != nil {
+			httpClient.Transport = c.transport
+		}
+		if c.timeout != 0 {
+			httpClient.Timeout = c.timeout
+		}
+		c.httpClient = &httpClient
+	}
+
+	c.handler = c.chain()
+
+	return c
+
+}
+
+/*
+ *	OAuth2AccessToken
+ *	Returns the OAuth 2.0 access token currently used to authorise the client.
+ *	@since	2.0.0
+ */
+func (c *Client) OAuth2AccessToken() string {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.token
+
+}
+
+/*
+ *	SetOAuth2AccessToken
+ *	Replaces the OAuth 2.0 access token used to authorise the client.
+ *	@since	2.0.0
+ */
+func (c *Client) SetOAuth2AccessToken(token string) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = token
+
+}
+
+/*
+ *	InstanceUrl
+ *	Returns the URL of the instance serving the org, or "" if it is not known.
+ *	@since	2.0.0
+ */
+func (c *Client) InstanceUrl() string {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.instanceUrl
+
+}
+
+/*
+ *	baseUrl
+ *	Returns the base URL of REST API calls: the instance URL if known, otherwise the My Domain URL.
+ *	@since	2.0.0
+ */
+func (c *Client) baseUrl() string {
+
+	if instanceUrl := c.InstanceUrl(); instanceUrl != "" {
+		return instanceUrl
+	}
+
+	return c.myDomainUrl()
+
+}
+
+/*
+ *	myDomainUrl
+ *	Returns the My Domain URL of the Salesforce org.
+ *	@since	2.0.0
+ */
+func (c *Client) myDomainUrl() string {
+
+	switch {
+	case c.sandbox != "":
+		return fmt.Sprintf("https://%s--%s.sandbox.my.salesforce.com", c.domain, c.sandbox)
+	case c.scratch:
+		return fmt.Sprintf("https://%s.scratch.my.salesforce.com", c.domain)
+	}
+
+	return fmt.Sprintf("https://%s.my.salesforce.com", c.domain)
+
+}
+
+/*
+ *	loginBaseUrl
+ *	Returns the base URL of the OAuth 2.0 endpoints.
+ *	@since	2.0.0
+ */
+func (c *Client) loginBaseUrl() string {
+
+	if c.loginUrl != "" {
+		return c.loginUrl
+	}
+
+	return c.myDomainUrl()
+
+}
+
+/*
+ *	dataUrl
+ *	Returns the URL of a REST API resource, relative to /services/data/{version}/.
+ *	@since	2.0.0
+ */
+func (c *Client) dataUrl(ctx context.Context, path string) string {
+
+	return c.baseUrl() + c.dataPath(ctx, path)
+
+}
+
+/*
+ *	dataPath
+ *	Returns the absolute path of a REST API resource given relative to /services/data/{version}/.
+ *	Absolute paths are returned unchanged.
+ *	@since	2.0.0
+ */
+func (c *Client) dataPath(ctx context.Context, path string) string {
+
+	if strings.HasPrefix(path, "/") {
+		return path
+	}
+
+	return "/services/data/" + c.ApiVersionFor(ctx) + "/" + path
+
+}
+
+/*
+ *	ApiVersion
+ *	Returns the version of the Salesforce REST API used by the client.
+ *	@since	2.0.0
+ */
+func (c *Client) ApiVersion() string {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.apiVersion
+
+}
+
+/*
+ *	ApiVersionFor
+ *	Returns the version of the Salesforce REST API to use for a call made with ctx,
+ *	honouring any override set with ContextWithApiVersion.
+ *	@since	2.0.0
+ */
+func (c *Client) ApiVersionFor(ctx context.Context) string {
+
+	if version, ok := ctx.Value(apiVersionKey{}).(string); ok && version != "" {
+		return version
+	}
+
+	if c.latestApiVersion {
+		c.negotiateApiVersionOnce(ctx)
+	}
+
+	return c.ApiVersion()
+
+}
+
+/*
+ *	newRequest
+ *	Returns a new authorised request for a Salesforce REST API resource.
+ *	@since	2.0.0
+ */
+func (c *Client) newRequest(ctx context.Context, method string, url string, body io.Reader) (*http.Request, error) {
+
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
 
 	request.Header.Add("Accept", "application/json")
-	request.Header.Add("Content-Type", "application/json; charset=UTF-8")
-	request.Header.Add("Authorization", OAuth2AccessToken)
+	request.Header.Add("Authorization", c.OAuth2AccessToken())
+
+	if body != nil {
+		request.Header.Add("Content-Type", "application/json; charset=UTF-8")
+	}
+
+	return request, nil
+
+}
+
+/*
+ *	send
+ *	Sends a request and returns the response, whatever its status code.
+ *	Transient failures are retried according to the retry policy of the client.
+ *	@since	2.0.0
+ */
+func (c *Client) send(request *http.Request) (*http.Response, error) {
+
+	for attempt := 1; ; attempt++ {
+		response, err := c.sendAuthorized(request)
+
+		if attempt >= c.retryPolicy.MaxAttempts || !isRetryable(request, response, err) {
+			return response, err
+		}
+
+		retry, ok := rewindRequest(request)
+		if !ok {
+			return response, err
+		}
+
+		delay := c.retryPolicy.backoff(attempt, response)
+
+		if response != nil {
+			response.Body.Close()
+		}
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(delay):
+		}
+
+		request = retry
+	}
+
+}
+
+/*
+ *	sendAuthorized
+ *	Sends a request and returns the response, whatever its status code.
+ *	If the access token is rejected, with 401 Unauthorized or with an INVALID_SESSION_ID fault of the SOAP
+ *	and Metadata APIs, and can be renewed, it is renewed and the request is sent again, once.
+ *	@since	2.0.0
+ */
+func (c *Client) sendAuthorized(request *http.Request) (*http.Response, error) {
+
+	response, err := c.roundTrip(request)
+	if err != nil || !sessionRejected(response) {
+		return response, err
+	}
+
+	staleToken := request.Header.Get("Authorization")
+	if staleToken == "" || !c.canRenewToken() {
+		return response, nil
+	}
+
+	retry, ok := rewindRequest(request)
+	if !ok {
+		return response, nil
+	}
+
+	response.Body.Close()
+
+	if err := c.renewToken(request.Context(), staleToken); err != nil {
+		return nil, err
+	}
+
+	retry.Header.Set("Authorization", c.OAuth2AccessToken())
+
+	if err := replaceSessionId(retry, staleToken, c.OAuth2AccessToken()); err != nil {
+		return nil, err
+	}
+
+	return c.roundTrip(retry)
 
-	response, _ := (&http.Client{}).Do(request)
+}
+
+/*
+ *	sessionRejected
+ *	Reports whether a response rejects the access token: with 401 Unauthorized,
+ *	or, for the SOAP and Metadata APIs, with a 500 INVALID_SESSION_ID fault.
+ *	The body of a fault is read and replaced, so it can still be decoded by the caller.
+ *	@since	2.0.0
+ */
+func sessionRejected(response *http.Response) bool {
+
+	if response.StatusCode == http.StatusUnauthorized {
+		return true
+	}
 
-	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusInternalServerError || !strings.Contains(response.Header.Get("Content-Type"), "xml") {
+		return false
+	}
 
+	data, err := io.ReadAll(response.Body)
 	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(data))
+
+	return err == nil && errors.Is(decodeSoapFault(response.StatusCode, data), ErrInvalidSession)
+
+}
+
+/*
+ *	replaceSessionId
+ *	Replaces the session ID of the stale access token in the SessionHeader of a SOAP request with that of the fresh one.
+ *	Requests without the stale session ID are left unchanged.
+ *	@since	2.0.0
+ */
+func replaceSessionId(request *http.Request, staleToken string, freshToken string) error {
+
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil
+	}
+
+	data, err := io.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	sessionElement := func(token string) []byte {
+		// The session ID is the access token without its type.
+		if _, sessionId, ok := strings.Cut(token, " "); ok {
+			token = sessionId
+		}
+
+		var element bytes.Buffer
+		element.WriteString("<sessionId>")
+		xml.EscapeText(&element, []byte(token))
+		element.WriteString("</sessionId>")
+		return element.Bytes()
+	}
+
+	data = bytes.Replace(data, sessionElement(staleToken), sessionElement(freshToken), 1)
+
+	request.Body = io.NopCloser(bytes.NewReader(data))
+	request.ContentLength = int64(len(data))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	return nil
+
+}
+
+/*
+ *	roundTrip
+ *	Sends a request once, within the rate limit of the client and with the headers of the client and its context,
+ *	and records the API usage reported by the response.
+ *	@since	2.0.0
+ */
+func (c *Client) roundTrip(request *http.Request) (*http.Response, error) {
+
+	if err := c.waitForRateLimit(request.Context()); err != nil {
+		return nil, err
+	}
+
+	for key, values := range c.header {
+		request.Header[key] = values
+	}
+
+	for key, values := range HeadersFromContext(request.Context()) {
+		request.Header[key] = values
+	}
+
+	response, err := c.handler(request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.recordApiUsage(response)
+
+	return response, nil
+
+}
+
+/*
+ *	rewindRequest
+ *	Returns a copy of a request that can be sent again, if its body can be replayed.
+ *	@since	2.0.0
+ */
+func rewindRequest(request *http.Request) (*http.Request, bool) {
+
+	retry := request.Clone(request.Context())
+
+	if request.Body == nil || request.Body == http.NoBody {
+		return retry, true
+	}
+
+	if request.GetBody == nil {
+		return nil, false
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, false
+	}
+
+	retry.Body = body
+
+	return retry, true
+
+}
+
+/*
+ *	do
+ *	Sends a request and returns the response if it has a 2xx status code.
+ *	Otherwise, closes the response and returns the error reported by Salesforce.
+ *	@since	2.0.0
+ */
+func (c *Client) do(request *http.Request) (*http.Response, error) {
 
-	return body
+	response, err := c.send(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResponse(response); err != nil {
+		response.Body.Close()
+		if retry, ok := c.overrideDuplicateRules(request, err); ok {
+			return c.do(retry)
+		}
+		return nil, err
+	}
+
+	return response, nil
+
+}
+
+/*
+ *	doJSON
+ *	Sends a request with in encoded as the JSON body, if not nil,
+ *	and decodes the JSON response into out, if not nil.
+ *	@since	2.0.0
+ */
+func (c *Client) doJSON(ctx context.Context, method string, url string, in interface{}, out interface{}) error {
+
+	var body io.Reader
+
+	if in != nil {
+		jsonData, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(jsonData)
+	}
+
+	request, err := c.newRequest(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	// 204 No Content
+	if err := json.NewDecoder(response.Body).Decode(out); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+
+}
+
+/*
+ *	resolveUrl
+ *	Returns the URL of a resource given as an absolute URL, a path from the instance URL starting with /,
+ *	or a path relative to /services/data/{version}/.
+ *	@since	2.0.0
+ */
+func (c *Client) resolveUrl(ctx context.Context, path string) string {
+
+	if strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		return path
+	}
+
+	return c.dataUrl(ctx, path)
+
+}
+
+/*
+ *	NewRequest
+ *	Returns a new authorised request for a resource given as an absolute URL,
+ *	a path from the instance URL starting with /, e.g. "/services/apexrest/orders",
+ *	or a path relative to /services/data/{version}/, e.g. "tooling/sobjects/".
+ *	A body is sent as JSON unless the Content-Type header is replaced.
+ *	@since	2.0.0
+ */
+func (c *Client) NewRequest(ctx context.Context, method string, path string, body io.Reader) (*http.Request, error) {
+
+	return c.newRequest(ctx, method, c.resolveUrl(ctx, path), body)
+
+}
+
+/*
+ *	Do
+ *	Sends a request created with NewRequest, renewing the access token and retrying as configured.
+ *	Returns a *SalesforceError, or SalesforceErrors, unless the response has a 2xx status code;
+ *	otherwise the caller must close the body of the response.
+ *	@since	2.0.0
+ */
+func (c *Client) Do(request *http.Request) (*http.Response, error) {
+
+	return c.do(request)
+
+}
+
+/*
+ *	DoJSON
+ *	Sends a request for a resource, given as for NewRequest, with in encoded as the JSON body, if not nil,
+ *	and decodes the JSON response into out, if not nil.
+ *	@since	2.0.0
+ */
+func (c *Client) DoJSON(ctx context.Context, method string, path string, in interface{}, out interface{}) error {
+
+	return c.doJSON(ctx, method, c.resolveUrl(ctx, path), in, out)
+
+}
+
+/*
+ *	GetOAuth2AccessToken
+ *	Obtains an OAuth 2.0 access token to authorise calls to the Salesforce REST API.
+ *	The token is stored on the client and used for subsequent calls.
+ *	@since	1.0.0
+ */
+func (c *Client) GetOAuth2AccessToken(ctx context.Context, client_id string, client_secret string) (string, error) {
+
+	data := url.Values{}
+	data.Set("grant_type", "client_credentials")
+	data.Set("client_id", client_id)
+	data.Set("client_secret", client_secret)
+
+	token, err := c.requestToken(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	c.setReauthenticate(func(ctx context.Context) error {
+		_, err := c.GetOAuth2AccessToken(ctx, client_id, client_secret)
+		return err
+	})
+
+	return token.TokenType + " " + token.AccessToken, nil
+
+}
+
+/*
+ *	Query
+ *	Executes a SOQL query and returns the JSON representation of the first page of records.
+ *	@since	1.0.0
+ */
+func (c *Client) Query(ctx context.Context, soql string) ([]byte, error) {
+
+	return c.queryRaw(ctx, "query", soql)
 
 }
 
 /*
  *	Create
+ *	Creates a record and returns its ID.
  *	@since	1.0.1
  */
-func Create(object string, data map[string]interface{}) (string, error) {
+func (c *Client) Create(ctx context.Context, object string, data map[string]interface{}) (string, error) {
+
+	data, err := c.withRecordType(ctx, object, data)
+	if err != nil {
+		return "", err
+	}
+
+	var result SaveResult
+
+	// 201 Created
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "sobjects/"+object+"/"), data, &result); err != nil {
+		return "", err
+	}
+
+	if err := result.Err(); err != nil {
+		return "", err
+	}
+
+	return result.Id, nil
+
+}
+
+/*
+ *	Update
+ *	Updates the fields of an existing record.
+ *	@since	2.0.0
+ */
+func (c *Client) Update(ctx context.Context, object string, id string, data map[string]interface{}) error {
+
+	return c.doJSON(ctx, http.MethodPatch, c.dataUrl(ctx, "sobjects/"+object+"/"+id), data, nil)
+
+}
+
+/*
+ *	Delete
+ *	Deletes an existing record.
+ *	The returned error matches ErrNotFound if the record does not exist and ErrLocked if it is locked.
+ *	@since	2.0.0
+ */
+func (c *Client) Delete(ctx context.Context, object string, id string) error {
 
-	jsonData, _ := json.Marshal(data)
+	return c.doJSON(ctx, http.MethodDelete, c.dataUrl(ctx, "sobjects/"+object+"/"+id), nil, nil)
 
-	request, _ := http.NewRequest(
-		http.MethodPost,
-		fmt.Sprintf("https://%s.my.salesforce.com/services/data/%s/sobjects/%s/", MyDomain, ApiVersion, object),
-		bytes.NewBuffer(jsonData),
-	)
+}
 
-	request.Header.Add("Authorization", OAuth2AccessToken)
-	request.Header.Add("Content-Type", "application/json; charset=UTF-8")
+/*
+ *	GetRecord
+ *	Returns the JSON representation of a record.
+ *	If no fields are given, all fields the user has access to are returned.
+ *	@since	2.0.0
+ */
+func (c *Client) GetRecord(ctx context.Context, object string, id string, fields ...string) ([]byte, error) {
 
-	response, _ := (&http.Client{}).Do(request)
+	path := "sobjects/" + object + "/" + id
+	if len(fields) > 0 {
+		path += "?fields=" + url.QueryEscape(strings.Join(fields, ","))
+	}
 
-	body, _ := io.ReadAll(response.Body)
+	request, err := c.newRequest(ctx, http.MethodGet, c.dataUrl(ctx, path), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	var query struct {
-		// 200 OK
-		Id      string `json:"id"`
-		Success bool
+	response, err := c.do(request)
+	if err != nil {
+		return nil, err
 	}
 
-	json.NewDecoder(bytes.NewReader(body)).Decode(&query)
+	defer response.Body.Close()
 
-	response.Body.Close()
+	return io.ReadAll(response.Body)
+
+}
+
+/*
+ *	GetRecordInto
+ *	Decodes a record into v, which must be a pointer.
+ *	If no fields are given, all fields the user has access to are returned.
+ *	@since	2.0.0
+ */
+func (c *Client) GetRecordInto(ctx context.Context, object string, id string, v interface{}, fields ...string) error {
+
+	body, err := c.GetRecord(ctx, object, id, fields...)
+	if err != nil {
+		return err
+	}
 
-	return query.Id, nil
+	return json.Unmarshal(body, v)
 
 }
@@ -0,0 +1,158 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+/*
+ *	SearchRequest
+ *	A parameterized search, equivalent to a SOSL FIND statement.
+ *	@since	2.0.0
+ */
+type SearchRequest struct {
+	// Search string; SOSL wildcards and operators are supported.
+	Q string `json:"q"`
+
+	// Scope of fields to search: ALL, NAME, EMAIL, PHONE, or SIDEBAR.
+	In string `json:"in,omitempty"`
+
+	// Fields to return for every object, unless overridden in SObjects.
+	Fields []string `json:"fields,omitempty"`
+
+	// Objects to search, with per-object fields, filters, and limits.
+	SObjects []SearchObject `json:"sobjects,omitempty"`
+
+	// Maximum number of results per object, unless overridden in SObjects.
+	DefaultLimit int `json:"defaultLimit,omitempty"`
+
+	// Maximum number of results across all objects.
+	OverallLimit int `json:"overallLimit,omitempty"`
+
+	// Number of results to skip.
+	Offset int `json:"offset,omitempty"`
+
+	// Data category filters, for Knowledge articles and questions.
+	DataCategories []SearchDataCategory `json:"dataCategories,omitempty"`
+
+	// Division to search in, for orgs with divisions enabled.
+	Division string `json:"division,omitempty"`
+
+	// Set to LABELS to return the display labels of the returned fields.
+	Metadata string `json:"metadata,omitempty"`
+}
+
+/*
+ *	SearchObject
+ *	An object to search and the fields to return for it.
+ *	@since	2.0.0
+ */
+type SearchObject struct {
+	Name    string   `json:"name"`
+	Fields  []string `json:"fields,omitempty"`
+	Where   string   `json:"where,omitempty"`
+	OrderBy string   `json:"orderBy,omitempty"`
+	Limit   int      `json:"limit,omitempty"`
+}
+
+/*
+ *	SearchDataCategory
+ *	A data category filter of a search.
+ *	@since	2.0.0
+ */
+type SearchDataCategory struct {
+	GroupName  string   `json:"groupName"`
+	Operator   string   `json:"operator"`
+	Categories []string `json:"categories"`
+}
+
+/*
+ *	SearchResult
+ *	The records matched by a search.
+ *	@since	2.0.0
+ */
+type SearchResult struct {
+	SearchRecords []json.RawMessage `json:"searchRecords"`
+
+	// Display labels of the returned fields, if requested.
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+}
+
+/*
+ *	ParameterizedSearch
+ *	Searches records without building a SOSL statement.
+ *	@since	2.0.0
+ */
+func (c *Client) ParameterizedSearch(ctx context.Context, search SearchRequest) (*SearchResult, error) {
+
+	var result SearchResult
+
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "parameterizedSearch/"), search, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	SuggestionResult
+ *	Records whose names match the beginning of a search string, for type-ahead lookups.
+ *	@since	2.0.0
+ */
+type SuggestionResult struct {
+	// Records with their Id, Name or equivalent, and attributes.
+	Records []json.RawMessage `json:"autoSuggestResults"`
+
+	// Whether more records than the limit matched.
+	HasMoreResults bool `json:"hasMoreResults"`
+}
+
+/*
+ *	SearchSuggestions
+ *	Returns up to limit records of the given objects, 5 by default, whose names start with the words of q,
+ *	which must have at least 2 characters, or 1 for Chinese, Japanese, and Korean.
+ *	Recently viewed records are suggested first.
+ *	@since	2.0.0
+ */
+func (c *Client) SearchSuggestions(ctx context.Context, q string, limit int, objects ...string) (*SuggestionResult, error) {
+
+	query := url.Values{}
+	query.Set("q", q)
+	query.Set("sobject", strings.Join(objects, ","))
+
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var result SuggestionResult
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "search/suggestions?"+query.Encode()), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
@@ -0,0 +1,140 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+)
+
+/*
+ *	Namespace of the SOAP API, using the partner WSDL.
+ *	@since	2.0.0
+ */
+const soapNamespace string = "urn:partner.soap.sforce.com"
+
+/*
+ *	soapResult
+ *	The result of a SOAP API call on a record, e.g. undelete.
+ *	@since	2.0.0
+ */
+type soapResult struct {
+	Id      string `xml:"id"`
+	Success bool   `xml:"success"`
+	Errors  []struct {
+		StatusCode string   `xml:"statusCode"`
+		Message    string   `xml:"message"`
+		Fields     []string `xml:"fields"`
+	} `xml:"errors"`
+}
+
+/*
+ *	saveResult
+ *	Converts the result to a SaveResult.
+ *	@since	2.0.0
+ */
+func (r *soapResult) saveResult() SaveResult {
+
+	result := SaveResult{Id: r.Id, Success: r.Success}
+
+	for _, e := range r.Errors {
+		result.Errors = append(result.Errors, SalesforceError{
+			Message:   e.Message,
+			ErrorCode: e.StatusCode,
+			Fields:    e.Fields,
+		})
+	}
+
+	return result
+
+}
+
+/*
+ *	soapCall
+ *	Calls a SOAP API operation with the given request element, in the partner namespace,
+ *	and decodes every result element of the response into results.
+ *	Faults are returned as a *SalesforceError.
+ *	@since	2.0.0
+ */
+func (c *Client) soapCall(ctx context.Context, operation interface{}, results *[]soapResult) error {
+
+	body, err := xml.Marshal(operation)
+	if err != nil {
+		return err
+	}
+
+	// The session ID is the access token without its type.
+	sessionId := c.OAuth2AccessToken()
+	if _, token, ok := strings.Cut(sessionId, " "); ok {
+		sessionId = token
+	}
+
+	var envelope bytes.Buffer
+
+	envelope.WriteString(xml.Header)
+	envelope.WriteString(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">`)
+	envelope.WriteString(`<soapenv:Header><SessionHeader xmlns="` + soapNamespace + `"><sessionId>`)
+	xml.EscapeText(&envelope, []byte(sessionId))
+	envelope.WriteString(`</sessionId></SessionHeader></soapenv:Header>`)
+	envelope.WriteString(`<soapenv:Body>`)
+	envelope.Write(body)
+	envelope.WriteString(`</soapenv:Body></soapenv:Envelope>`)
+
+	request, err := c.NewRequest(ctx, http.MethodPost, "/services/Soap/u/"+strings.TrimPrefix(c.ApiVersionFor(ctx), "v"), &envelope)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "text/xml")
+	request.Header.Set("Content-Type", "text/xml; charset=UTF-8")
+	request.Header.Set("SOAPAction", `""`)
+
+	response, err := c.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	var decoded struct {
+		Body struct {
+			Response struct {
+				Results []soapResult `xml:"result"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*results = decoded.Body.Response.Results
+
+	return nil
+
+}
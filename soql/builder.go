@@ -0,0 +1,563 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package soql
+
+// Import standard packages.
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+/*
+ *	Condition
+ *	A condition expression of a WHERE clause.
+ *	@since	2.0.0
+ */
+type Condition struct {
+	expr string
+
+	// Why the condition is invalid, e.g. an empty IN list, if it is.
+	err error
+}
+
+/*
+ *	String
+ *	@since	2.0.0
+ */
+func (c Condition) String() string {
+
+	return c.expr
+
+}
+
+/*
+ *	Raw
+ *	Returns a condition written into the query verbatim.
+ *	Never use Raw for untrusted input.
+ *	@since	2.0.0
+ */
+func Raw(expr string) Condition {
+
+	return Condition{expr: expr}
+
+}
+
+/*
+ *	compare
+ *	@since	2.0.0
+ */
+func compare(field string, operator string, value interface{}) Condition {
+
+	return Condition{expr: field + " " + operator + " " + Quote(value)}
+
+}
+
+/*
+ *	Eq
+ *	field = value
+ *	@since	2.0.0
+ */
+func Eq(field string, value interface{}) Condition {
+
+	return compare(field, "=", value)
+
+}
+
+/*
+ *	Ne
+ *	field != value
+ *	@since	2.0.0
+ */
+func Ne(field string, value interface{}) Condition {
+
+	return compare(field, "!=", value)
+
+}
+
+/*
+ *	Lt
+ *	field < value
+ *	@since	2.0.0
+ */
+func Lt(field string, value interface{}) Condition {
+
+	return compare(field, "<", value)
+
+}
+
+/*
+ *	Lte
+ *	field <= value
+ *	@since	2.0.0
+ */
+func Lte(field string, value interface{}) Condition {
+
+	return compare(field, "<=", value)
+
+}
+
+/*
+ *	Gt
+ *	field > value
+ *	@since	2.0.0
+ */
+func Gt(field string, value interface{}) Condition {
+
+	return compare(field, ">", value)
+
+}
+
+/*
+ *	Gte
+ *	field >= value
+ *	@since	2.0.0
+ */
+func Gte(field string, value interface{}) Condition {
+
+	return compare(field, ">=", value)
+
+}
+
+/*
+ *	Like
+ *	field LIKE pattern
+ *	The % and _ wildcards in the pattern are not escaped.
+ *	@since	2.0.0
+ */
+func Like(field string, pattern string) Condition {
+
+	return compare(field, "LIKE", pattern)
+
+}
+
+/*
+ *	compareList
+ *	Compares a field with a list of values, which must be a non-empty slice or array,
+ *	since SOQL rejects an empty list. An invalid condition keeps its text, e.g. Id IN (),
+ *	so a query written with String is rejected by Salesforce rather than losing the filter.
+ *	@since	2.0.0
+ */
+func compareList(field string, operator string, values interface{}) Condition {
+
+	condition := compare(field, operator, values)
+
+	rv := reflect.ValueOf(values)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		condition.err = errors.New("soql: " + operator + " values of " + field + " must be a slice or an array")
+	} else if rv.Len() == 0 {
+		condition.err = errors.New("soql: empty list for " + operator + " of " + field)
+	}
+
+	return condition
+
+}
+
+/*
+ *	In
+ *	field IN (values...)
+ *	values must be a non-empty slice or array; otherwise the query fails to build.
+ *	@since	2.0.0
+ */
+func In(field string, values interface{}) Condition {
+
+	return compareList(field, "IN", values)
+
+}
+
+/*
+ *	NotIn
+ *	field NOT IN (values...)
+ *	values must be a non-empty slice or array; otherwise the query fails to build.
+ *	@since	2.0.0
+ */
+func NotIn(field string, values interface{}) Condition {
+
+	return compareList(field, "NOT IN", values)
+
+}
+
+/*
+ *	InQuery
+ *	field IN (SELECT ...), a semi-join.
+ *	@since	2.0.0
+ */
+func InQuery(field string, query *Query) Condition {
+
+	return Condition{field + " IN (" + query.String() + ")", query.Err()}
+
+}
+
+/*
+ *	Includes
+ *	field INCLUDES (values...), for multi-select picklists.
+ *	@since	2.0.0
+ */
+func Includes(field string, values ...string) Condition {
+
+	return compareList(field, "INCLUDES", values)
+
+}
+
+/*
+ *	Excludes
+ *	field EXCLUDES (values...), for multi-select picklists.
+ *	@since	2.0.0
+ */
+func Excludes(field string, values ...string) Condition {
+
+	return compareList(field, "EXCLUDES", values)
+
+}
+
+/*
+ *	join
+ *	@since	2.0.0
+ */
+func join(operator string, conditions []Condition) Condition {
+
+	var err error
+
+	exprs := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		if err == nil {
+			err = c.err
+		}
+		if c.expr != "" {
+			exprs = append(exprs, c.expr)
+		}
+	}
+
+	if len(exprs) < 2 {
+		return Condition{strings.Join(exprs, ""), err}
+	}
+
+	return Condition{"(" + strings.Join(exprs, " "+operator+" ") + ")", err}
+
+}
+
+/*
+ *	clause
+ *	Joins the conditions of a WHERE or HAVING clause with AND, without enclosing parentheses.
+ *	@since	2.0.0
+ */
+func clause(conditions []Condition) string {
+
+	exprs := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		if c.expr != "" {
+			exprs = append(exprs, c.expr)
+		}
+	}
+
+	return strings.Join(exprs, " AND ")
+
+}
+
+/*
+ *	And
+ *	(a AND b AND ...)
+ *	@since	2.0.0
+ */
+func And(conditions ...Condition) Condition {
+
+	return join("AND", conditions)
+
+}
+
+/*
+ *	Or
+ *	(a OR b OR ...)
+ *	@since	2.0.0
+ */
+func Or(conditions ...Condition) Condition {
+
+	return join("OR", conditions)
+
+}
+
+/*
+ *	Not
+ *	(NOT c)
+ *	@since	2.0.0
+ */
+func Not(c Condition) Condition {
+
+	if c.expr == "" {
+		return c
+	}
+
+	return Condition{"(NOT " + c.expr + ")", c.err}
+
+}
+
+/*
+ *	Query
+ *	A SOQL query under construction.
+ *	@since	2.0.0
+ */
+type Query struct {
+	fields  []string
+	object  string
+	where   []Condition
+	groupBy []string
+	having  []Condition
+	orderBy []string
+	limit   int
+	offset  int
+	suffix  string
+
+	// Why the query is invalid, from its subqueries, if it is.
+	err error
+}
+
+/*
+ *	Select
+ *	Starts a query selecting the given fields.
+ *	Relationship fields are written in dot notation, e.g. Account.Name.
+ *	@since	2.0.0
+ */
+func Select(fields ...string) *Query {
+
+	return &Query{fields: fields}
+
+}
+
+/*
+ *	Select
+ *	Adds fields to the select list.
+ *	@since	2.0.0
+ */
+func (q *Query) Select(fields ...string) *Query {
+
+	q.fields = append(q.fields, fields...)
+	return q
+
+}
+
+/*
+ *	Subquery
+ *	Adds a parent-to-child relationship subquery to the select list,
+ *	e.g. Select("Id").From("Contacts").
+ *	@since	2.0.0
+ */
+func (q *Query) Subquery(subquery *Query) *Query {
+
+	q.fields = append(q.fields, "("+subquery.String()+")")
+	if q.err == nil {
+		q.err = subquery.Err()
+	}
+	return q
+
+}
+
+/*
+ *	From
+ *	Sets the object to query.
+ *	@since	2.0.0
+ */
+func (q *Query) From(object string) *Query {
+
+	q.object = object
+	return q
+
+}
+
+/*
+ *	Where
+ *	Adds conditions to the WHERE clause; all conditions must be met.
+ *	@since	2.0.0
+ */
+func (q *Query) Where(conditions ...Condition) *Query {
+
+	q.where = append(q.where, conditions...)
+	return q
+
+}
+
+/*
+ *	GroupBy
+ *	Adds fields to the GROUP BY clause.
+ *	@since	2.0.0
+ */
+func (q *Query) GroupBy(fields ...string) *Query {
+
+	q.groupBy = append(q.groupBy, fields...)
+	return q
+
+}
+
+/*
+ *	Having
+ *	Adds conditions to the HAVING clause; all conditions must be met.
+ *	@since	2.0.0
+ */
+func (q *Query) Having(conditions ...Condition) *Query {
+
+	q.having = append(q.having, conditions...)
+	return q
+
+}
+
+/*
+ *	OrderBy
+ *	Adds a field to the ORDER BY clause in ascending order.
+ *	@since	2.0.0
+ */
+func (q *Query) OrderBy(field string) *Query {
+
+	q.orderBy = append(q.orderBy, field+" ASC")
+	return q
+
+}
+
+/*
+ *	OrderByDesc
+ *	Adds a field to the ORDER BY clause in descending order.
+ *	@since	2.0.0
+ */
+func (q *Query) OrderByDesc(field string) *Query {
+
+	q.orderBy = append(q.orderBy, field+" DESC")
+	return q
+
+}
+
+/*
+ *	Limit
+ *	Sets the maximum number of rows to return.
+ *	@since	2.0.0
+ */
+func (q *Query) Limit(n int) *Query {
+
+	q.limit = n
+	return q
+
+}
+
+/*
+ *	Offset
+ *	Sets the number of rows to skip.
+ *	@since	2.0.0
+ */
+func (q *Query) Offset(n int) *Query {
+
+	q.offset = n
+	return q
+
+}
+
+/*
+ *	ForUpdate
+ *	Locks the returned records until the transaction ends.
+ *	@since	2.0.0
+ */
+func (q *Query) ForUpdate() *Query {
+
+	q.suffix = " FOR UPDATE"
+	return q
+
+}
+
+/*
+ *	Err
+ *	Returns why the query is invalid, e.g. because of an empty IN list, or nil if it is valid.
+ *	@since	2.0.0
+ */
+func (q *Query) Err() error {
+
+	if q.err != nil {
+		return q.err
+	}
+
+	for _, c := range append(append([]Condition(nil), q.where...), q.having...) {
+		if c.err != nil {
+			return c.err
+		}
+	}
+
+	return nil
+
+}
+
+/*
+ *	Build
+ *	Returns the SOQL query, or why it is invalid.
+ *	@since	2.0.0
+ */
+func (q *Query) Build() (string, error) {
+
+	if err := q.Err(); err != nil {
+		return "", err
+	}
+
+	return q.String(), nil
+
+}
+
+/*
+ *	String
+ *	Returns the SOQL query. Invalid conditions are written as they are, so Salesforce rejects the query;
+ *	use Build to detect them before sending it.
+ *	@since	2.0.0
+ */
+func (q *Query) String() string {
+
+	var b strings.Builder
+
+	b.WriteString("SELECT ")
+	b.WriteString(strings.Join(q.fields, ", "))
+	b.WriteString(" FROM ")
+	b.WriteString(q.object)
+
+	if where := clause(q.where); where != "" {
+		b.WriteString(" WHERE ")
+		b.WriteString(where)
+	}
+
+	if len(q.groupBy) > 0 {
+		b.WriteString(" GROUP BY ")
+		b.WriteString(strings.Join(q.groupBy, ", "))
+	}
+
+	if having := clause(q.having); having != "" {
+		b.WriteString(" HAVING ")
+		b.WriteString(having)
+	}
+
+	if len(q.orderBy) > 0 {
+		b.WriteString(" ORDER BY ")
+		b.WriteString(strings.Join(q.orderBy, ", "))
+	}
+
+	if q.limit > 0 {
+		b.WriteString(" LIMIT ")
+		b.WriteString(strconv.Itoa(q.limit))
+	}
+
+	if q.offset > 0 {
+		b.WriteString(" OFFSET ")
+		b.WriteString(strconv.Itoa(q.offset))
+	}
+
+	b.WriteString(q.suffix)
+
+	return b.String()
+
+}
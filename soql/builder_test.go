@@ -0,0 +1,177 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package soql
+
+// Import standard packages.
+import (
+	"testing"
+)
+
+/*
+ *	TestBuild
+ *	@since	2.0.0
+ */
+func TestBuild(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		query   *Query
+		want    string
+		invalid bool
+	}{
+		{
+			name:  "conditions",
+			query: Select("Id", "Name").From("Account").Where(Eq("Name", "O'Brien"), Gt("NumberOfEmployees", Score(10))).Limit(5),
+			want:  `SELECT Id, Name FROM Account WHERE Name = 'O\'Brien' AND NumberOfEmployees > 10 LIMIT 5`,
+		},
+		{
+			name:  "in",
+			query: Select("Id").From("Account").Where(In("Id", []string{"001A", "001B"})),
+			want:  "SELECT Id FROM Account WHERE Id IN ('001A', '001B')",
+		},
+		{
+			name:  "or",
+			query: Select("Id").From("Account").Where(Or(Eq("Type", "Customer"), NotIn("Industry", []string{"Retail"}))),
+			want:  "SELECT Id FROM Account WHERE (Type = 'Customer' OR Industry NOT IN ('Retail'))",
+		},
+		{
+			name:    "nil in",
+			query:   Select("Id").From("Account").Where(In("Id", nil)),
+			invalid: true,
+		},
+		{
+			name:    "empty in",
+			query:   Select("Id").From("Account").Where(In("Id", []string{})),
+			invalid: true,
+		},
+		{
+			name:    "empty includes",
+			query:   Select("Id").From("Account").Where(Includes("Regions__c")),
+			invalid: true,
+		},
+		{
+			name:    "nested empty in",
+			query:   Select("Id").From("Account").Where(Not(Or(Eq("Type", "Customer"), In("Id", []string{})))),
+			invalid: true,
+		},
+		{
+			name:    "semi-join with empty in",
+			query:   Select("Id").From("Account").Where(InQuery("Id", Select("AccountId").From("Contact").Where(In("Id", []string{})))),
+			invalid: true,
+		},
+		{
+			name:    "subquery with empty in",
+			query:   Select("Id").Subquery(Select("Id").From("Contacts").Where(In("Id", []string{}))).From("Account"),
+			invalid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.query.Build()
+
+			if test.invalid {
+				if err == nil {
+					t.Fatalf("Build() = %s, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Build() = %s, want %s", got, test.want)
+			}
+		})
+	}
+
+}
+
+/*
+ *	TestStringKeepsInvalidConditions
+ *	Invalid conditions must never be dropped from the query, which would then match more records.
+ *	@since	2.0.0
+ */
+func TestStringKeepsInvalidConditions(t *testing.T) {
+
+	empty := []string{}
+
+	tests := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name:  "in",
+			query: Select("Id").From("Account").Where(In("Id", empty)),
+			want:  "SELECT Id FROM Account WHERE Id IN ()",
+		},
+		{
+			name:  "nil in",
+			query: Select("Id").From("Account").Where(In("Id", nil)),
+			want:  "SELECT Id FROM Account WHERE Id IN null",
+		},
+		{
+			name:  "and",
+			query: Select("Id").From("Account").Where(And(Eq("Type", "Customer"), In("Id", empty))),
+			want:  "SELECT Id FROM Account WHERE (Type = 'Customer' AND Id IN ())",
+		},
+		{
+			name:  "or",
+			query: Select("Id").From("Account").Where(Or(In("Id", empty), Eq("Type", "Customer"))),
+			want:  "SELECT Id FROM Account WHERE (Id IN () OR Type = 'Customer')",
+		},
+		{
+			name:  "not",
+			query: Select("Id").From("Account").Where(Not(In("Id", empty))),
+			want:  "SELECT Id FROM Account WHERE (NOT Id IN ())",
+		},
+		{
+			name:  "nested",
+			query: Select("Id").From("Account").Where(Eq("Type", "Customer"), Not(Or(Eq("Industry", "Retail"), NotIn("Id", empty)))),
+			want:  "SELECT Id FROM Account WHERE Type = 'Customer' AND (NOT (Industry = 'Retail' OR Id NOT IN ()))",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.query.String(); got != test.want {
+				t.Errorf("String() = %s, want %s", got, test.want)
+			}
+
+			if _, err := test.query.Build(); err == nil {
+				t.Error("Build() succeeded, want an error")
+			}
+		})
+	}
+
+}
+
+/*
+ *	TestNotEmpty
+ *	@since	2.0.0
+ */
+func TestNotEmpty(t *testing.T) {
+
+	if got := Select("Id").From("Account").Where(Not(And())).String(); got != "SELECT Id FROM Account" {
+		t.Errorf("String() = %s, want no WHERE clause", got)
+	}
+
+}
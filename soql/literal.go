@@ -0,0 +1,190 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package soql
+
+// Import standard packages.
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ *	Literal
+ *	A value written into a query verbatim, e.g. a date literal such as LAST_N_DAYS:30.
+ *	Never use a Literal for untrusted input.
+ *	@since	2.0.0
+ */
+type Literal string
+
+/*
+ *	Date
+ *	A date value, written into a query as YYYY-MM-DD.
+ *	time.Time values are written as date-time values instead.
+ *	@since	2.0.0
+ */
+type Date time.Time
+
+/*
+ *	escaper
+ *	Escapes the reserved characters of a string literal.
+ *	@since	2.0.0
+ */
+var escaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"\b", `\b`,
+	"\f", `\f`,
+)
+
+/*
+ *	Escape
+ *	Escapes the reserved characters of a string so it can be placed between single quotes.
+ *	@since	2.0.0
+ */
+func Escape(s string) string {
+
+	return escaper.Replace(s)
+
+}
+
+/*
+ *	Quote
+ *	Formats a Go value as a SOQL literal.
+ *	Strings are quoted and escaped, times are formatted as UTC date-time values,
+ *	and slices and arrays are formatted as parenthesised lists for use with IN.
+ *	@since	2.0.0
+ */
+func Quote(v interface{}) string {
+
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case Literal:
+		return string(v)
+	case string:
+		return "'" + Escape(v) + "'"
+	case bool:
+		return strconv.FormatBool(v)
+	case Date:
+		return time.Time(v).Format(time.DateOnly)
+	case time.Time:
+		return v.UTC().Format("2006-01-02T15:04:05Z")
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case fmt.Stringer:
+		return "'" + Escape(v.String()) + "'"
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "null"
+		}
+		return Quote(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		values := make([]string, rv.Len())
+		for i := range values {
+			values[i] = Quote(rv.Index(i).Interface())
+		}
+		return "(" + strings.Join(values, ", ") + ")"
+
+	// Named types, e.g. type Score int, are formatted as their underlying type.
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	case reflect.String:
+		return "'" + Escape(rv.String()) + "'"
+	}
+
+	return "'" + Escape(fmt.Sprint(v)) + "'"
+
+}
+
+/*
+ *	Format
+ *	Binds values to the ? placeholders of a query, in order, formatting each with Quote.
+ *	Slices are expanded into parenthesised lists, e.g. for Id IN ?.
+ *	Question marks inside string literals of the query are left untouched.
+ *	@since	2.0.0
+ */
+func Format(query string, args ...interface{}) (string, error) {
+
+	var b strings.Builder
+
+	n := 0
+	quoted := false
+
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+
+		switch {
+		case quoted && ch == '\\' && i+1 < len(query):
+			b.WriteByte(ch)
+			i++
+			ch = query[i]
+		case ch == '\'':
+			quoted = !quoted
+		case !quoted && ch == '?':
+			if n >= len(args) {
+				return "", fmt.Errorf("soql: missing value for parameter %d", n+1)
+			}
+
+			if rv := reflect.ValueOf(args[n]); (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Len() == 0 {
+				return "", fmt.Errorf("soql: empty list for parameter %d", n+1)
+			}
+
+			b.WriteString(Quote(args[n]))
+			n++
+			continue
+		}
+
+		b.WriteByte(ch)
+	}
+
+	if quoted {
+		return "", errors.New("soql: unterminated string literal")
+	}
+
+	if n < len(args) {
+		return "", fmt.Errorf("soql: %d parameters given but only %d placeholders", len(args), n)
+	}
+
+	return b.String(), nil
+
+}
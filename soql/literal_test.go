@@ -0,0 +1,203 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package soql
+
+// Import standard packages.
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+ *	Score
+ *	A named numeric type.
+ *	@since	2.0.0
+ */
+type Score int
+
+/*
+ *	Ratio
+ *	A named floating-point type.
+ *	@since	2.0.0
+ */
+type Ratio float64
+
+/*
+ *	Status
+ *	A named string type.
+ *	@since	2.0.0
+ */
+type Status string
+
+/*
+ *	TestQuote
+ *	@since	2.0.0
+ */
+func TestQuote(t *testing.T) {
+
+	count := 3
+	var missing *int
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"nil", nil, "null"},
+		{"nil pointer", missing, "null"},
+		{"pointer", &count, "3"},
+		{"string", "Acme", "'Acme'"},
+		{"single quote", "O'Brien", `'O\'Brien'`},
+		{"injection", "x' OR Name != '", `'x\' OR Name != \''`},
+		{"double quote", `say "hi"`, `'say \"hi\"'`},
+		{"backslash", `C:\temp`, `'C:\\temp'`},
+		{"escaped quote", `\'`, `'\\\''`},
+		{"newline", "a\nb", `'a\nb'`},
+		{"tab", "a\tb", `'a\tb'`},
+		{"carriage return", "a\rb", `'a\rb'`},
+		{"bool", true, "true"},
+		{"int", 42, "42"},
+		{"negative int", int64(-7), "-7"},
+		{"uint", uint8(255), "255"},
+		{"float", 1.5, "1.5"},
+		{"float32", float32(0.25), "0.25"},
+		{"named int", Score(5), "5"},
+		{"named float", Ratio(0.5), "0.5"},
+		{"named string", Status("it's"), `'it\'s'`},
+		{"literal", Literal("LAST_N_DAYS:30"), "LAST_N_DAYS:30"},
+		{"time", time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)), "2024-01-02T02:04:05Z"},
+		{"date", Date(time.Date(2024, 1, 2, 23, 0, 0, 0, time.UTC)), "2024-01-02"},
+		{"string slice", []string{"a", "b'c"}, `('a', 'b\'c')`},
+		{"int slice", []int{1, 2}, "(1, 2)"},
+		{"named int slice", []Score{1, 2}, "(1, 2)"},
+		{"array", [2]string{"a", "b"}, "('a', 'b')"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Quote(test.value); got != test.want {
+				t.Errorf("Quote(%#v) = %s, want %s", test.value, got, test.want)
+			}
+		})
+	}
+
+}
+
+/*
+ *	TestFormat
+ *	@since	2.0.0
+ */
+func TestFormat(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		query string
+		args  []interface{}
+		want  string
+		err   string
+	}{
+		{
+			name:  "placeholders",
+			query: "SELECT Id FROM Account WHERE Name = ? AND NumberOfEmployees > ?",
+			args:  []interface{}{"O'Brien", 10},
+			want:  `SELECT Id FROM Account WHERE Name = 'O\'Brien' AND NumberOfEmployees > 10`,
+		},
+		{
+			name:  "in list",
+			query: "SELECT Id FROM Account WHERE Id IN ?",
+			args:  []interface{}{[]string{"001A", "001B"}},
+			want:  "SELECT Id FROM Account WHERE Id IN ('001A', '001B')",
+		},
+		{
+			name:  "question mark in literal",
+			query: "SELECT Id FROM Account WHERE Name = 'Who?' AND Id = ?",
+			args:  []interface{}{"001A"},
+			want:  "SELECT Id FROM Account WHERE Name = 'Who?' AND Id = '001A'",
+		},
+		{
+			name:  "escaped quote in literal",
+			query: `SELECT Id FROM Account WHERE Name = 'It\'s ?' AND Id = ?`,
+			args:  []interface{}{"001A"},
+			want:  `SELECT Id FROM Account WHERE Name = 'It\'s ?' AND Id = '001A'`,
+		},
+		{
+			name:  "value with placeholder",
+			query: "SELECT Id FROM Account WHERE Name = ? AND Id = ?",
+			args:  []interface{}{"?", "001A"},
+			want:  "SELECT Id FROM Account WHERE Name = '?' AND Id = '001A'",
+		},
+		{
+			name:  "named int",
+			query: "SELECT Id FROM Lead WHERE Score__c >= ?",
+			args:  []interface{}{Score(5)},
+			want:  "SELECT Id FROM Lead WHERE Score__c >= 5",
+		},
+		{
+			name:  "empty list",
+			query: "SELECT Id FROM Account WHERE Id IN ?",
+			args:  []interface{}{[]string{}},
+			err:   "empty list for parameter 1",
+		},
+		{
+			name:  "nil list",
+			query: "SELECT Id FROM Account WHERE Id IN ?",
+			args:  []interface{}{[]string(nil)},
+			err:   "empty list for parameter 1",
+		},
+		{
+			name:  "missing value",
+			query: "SELECT Id FROM Account WHERE Name = ? AND Id = ?",
+			args:  []interface{}{"Acme"},
+			err:   "missing value for parameter 2",
+		},
+		{
+			name:  "extra value",
+			query: "SELECT Id FROM Account WHERE Name = ?",
+			args:  []interface{}{"Acme", "extra"},
+			err:   "2 parameters given but only 1 placeholders",
+		},
+		{
+			name:  "unterminated literal",
+			query: "SELECT Id FROM Account WHERE Name = 'Acme",
+			err:   "unterminated string literal",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Format(test.query, test.args...)
+
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("Format() error = %v, want %q", err, test.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Format() = %s, want %s", got, test.want)
+			}
+		})
+	}
+
+}
@@ -0,0 +1,642 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package streaming
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Replay IDs with special meaning when subscribing.
+ *	@since	2.0.0
+ */
+const (
+	// Receive only events published after subscribing.
+	ReplayNew int64 = -1
+
+	// Receive all events retained by Salesforce, up to 72 hours old, then new events.
+	ReplayAll int64 = -2
+)
+
+/*
+ *	Event
+ *	An event received on a channel.
+ *	PushTopic events carry the record in SObject; platform and change events carry Payload.
+ *	@since	2.0.0
+ */
+type Event struct {
+	Channel     string
+	ReplayId    int64
+	CreatedDate string
+
+	// Type of change of PushTopic events: created, updated, deleted, or undeleted.
+	Type string
+
+	SObject json.RawMessage
+	Payload json.RawMessage
+
+	// The data of the event as received.
+	Data json.RawMessage
+}
+
+/*
+ *	Handler
+ *	Handles the events received on a channel. Handlers are called sequentially.
+ *	@since	2.0.0
+ */
+type Handler func(ctx context.Context, event Event)
+
+/*
+ *	Client
+ *	A subscriber to the Salesforce Streaming API using CometD long polling,
+ *	for PushTopics (/topic/...), generic events (/u/...), platform events (/event/...), and change events (/data/...).
+ *	Long polls last up to two minutes, so the HTTP client of the salesforce.Client must not time out sooner.
+ *	@since	2.0.0
+ */
+type Client struct {
+	// Client of the org, used to send requests.
+	client *salesforce.Client
+
+	// Holds the cookies of the CometD session.
+	jar http.CookieJar
+
+	// Stores the replay ID of the last event handled on each channel, if set.
+	replayStore salesforce.ReplayStore
+
+	// Backoff between reconnection attempts; MaxAttempts limits consecutive failures, unlimited if 0.
+	reconnectPolicy salesforce.RetryPolicy
+
+	// Called when the state of the connection changes, if set.
+	stateCallback func(state salesforce.ConnectionState, err error)
+
+	// Guards the fields below.
+	mu sync.Mutex
+
+	// ID assigned to the client by the handshake.
+	clientId string
+
+	// Subscriptions by channel.
+	subscriptions map[string]*subscription
+}
+
+/*
+ *	subscription
+ *	@since	2.0.0
+ */
+type subscription struct {
+	handler Handler
+
+	// Replay ID to resume from after a new handshake: that of the last event handled.
+	replayId int64
+}
+
+/*
+ *	message
+ *	A Bayeux message.
+ *	@since	2.0.0
+ */
+type message struct {
+	Channel                  string                 `json:"channel"`
+	ClientId                 string                 `json:"clientId,omitempty"`
+	Version                  string                 `json:"version,omitempty"`
+	MinimumVersion           string                 `json:"minimumVersion,omitempty"`
+	SupportedConnectionTypes []string               `json:"supportedConnectionTypes,omitempty"`
+	ConnectionType           string                 `json:"connectionType,omitempty"`
+	Subscription             string                 `json:"subscription,omitempty"`
+	Successful               bool                   `json:"successful,omitempty"`
+	Error                    string                 `json:"error,omitempty"`
+	Advice                   *advice                `json:"advice,omitempty"`
+	Data                     json.RawMessage        `json:"data,omitempty"`
+	Ext                      map[string]interface{} `json:"ext,omitempty"`
+}
+
+/*
+ *	advice
+ *	How the server advises the client to reconnect.
+ *	@since	2.0.0
+ */
+type advice struct {
+	// retry, handshake, or none.
+	Reconnect string `json:"reconnect"`
+
+	// Milliseconds to wait before reconnecting.
+	Interval int `json:"interval"`
+
+	// Milliseconds the server holds a long poll.
+	Timeout int `json:"timeout"`
+}
+
+/*
+ *	Option
+ *	Configures a Client.
+ *	@since	2.0.0
+ */
+type Option func(*Client)
+
+/*
+ *	WithReplayStore
+ *	Stores the replay ID of each event once its handler returns, and resumes subscriptions after the stored IDs,
+ *	in preference to the replay IDs given to Subscribe.
+ *	@since	2.0.0
+ */
+func WithReplayStore(store salesforce.ReplayStore) Option {
+
+	return func(s *Client) {
+		s.replayStore = store
+	}
+
+}
+
+/*
+ *	WithReconnect
+ *	Sets the backoff between reconnection attempts after the connection is lost or a handshake fails.
+ *	MaxAttempts limits consecutive failed attempts; by default, the subscriber reconnects indefinitely.
+ *	@since	2.0.0
+ */
+func WithReconnect(policy salesforce.RetryPolicy) Option {
+
+	return func(s *Client) {
+		s.reconnectPolicy = policy
+	}
+
+}
+
+/*
+ *	WithStateCallback
+ *	Sets a function called when the state of the connection changes,
+ *	with the error that caused reconnecting or disconnecting, if any.
+ *	@since	2.0.0
+ */
+func WithStateCallback(callback func(state salesforce.ConnectionState, err error)) Option {
+
+	return func(s *Client) {
+		s.stateCallback = callback
+	}
+
+}
+
+/*
+ *	NewClient
+ *	Returns a Streaming API subscriber sending requests through the given client,
+ *	with its authentication, API version, and options.
+ *	@since	2.0.0
+ */
+func NewClient(client *salesforce.Client, opts ...Option) *Client {
+
+	jar, _ := cookiejar.New(nil)
+
+	s := &Client{
+		client:        client,
+		jar:           jar,
+		subscriptions: make(map[string]*subscription),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+
+}
+
+/*
+ *	Subscribe
+ *	Registers a handler for the events of a channel, e.g. /event/Order_Placed__e,
+ *	starting after the given replay ID, or from ReplayNew or ReplayAll.
+ *	Subscriptions registered while Run is running take effect on the next handshake.
+ *	@since	2.0.0
+ */
+func (s *Client) Subscribe(channel string, replayId int64, handler Handler) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscriptions[channel] = &subscription{handler: handler, replayId: replayId}
+
+}
+
+/*
+ *	Run
+ *	Connects to the Streaming API, subscribes to the registered channels,
+ *	and delivers events to their handlers until the context is cancelled.
+ *	When the connection is lost or the client ID expires (403::Unknown client),
+ *	Run handshakes again after a backoff, resuming after the last events handled.
+ *	It returns when reconnecting fails too many times, a subscription is refused, the server advises not to reconnect,
+ *	or an event cannot be decoded.
+ *	@since	2.0.0
+ */
+func (s *Client) Run(ctx context.Context) error {
+
+	defer s.disconnect()
+
+	if err := s.restoreReplayIds(ctx); err != nil {
+		return err
+	}
+
+	s.setState(salesforce.StateConnecting, nil)
+
+	failures := 0
+	for {
+		connected, err := s.session(ctx)
+		if ctx.Err() != nil {
+			s.setState(salesforce.StateDisconnected, ctx.Err())
+			return ctx.Err()
+		}
+
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			s.setState(salesforce.StateDisconnected, permanent.err)
+			return permanent.err
+		}
+
+		if connected {
+			failures = 0
+		}
+		failures++
+
+		if s.reconnectPolicy.MaxAttempts > 0 && failures >= s.reconnectPolicy.MaxAttempts {
+			s.setState(salesforce.StateDisconnected, err)
+			return err
+		}
+
+		s.setState(salesforce.StateReconnecting, err)
+
+		select {
+		case <-ctx.Done():
+			s.setState(salesforce.StateDisconnected, ctx.Err())
+			return ctx.Err()
+		case <-time.After(s.reconnectPolicy.Backoff(failures)):
+		}
+	}
+
+}
+
+/*
+ *	session
+ *	Handshakes and delivers events until the session ends, and returns why.
+ *	Reports whether the handshake succeeded, so that failures are counted from the last connection.
+ *	@since	2.0.0
+ */
+func (s *Client) session(ctx context.Context) (bool, error) {
+
+	if err := s.handshake(ctx); err != nil {
+		return false, err
+	}
+
+	s.setState(salesforce.StateConnected, nil)
+
+	for {
+		responses, err := s.connect(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		for _, response := range responses {
+			if response.Channel != "/meta/connect" {
+				if err := s.deliver(ctx, response); err != nil {
+					return true, err
+				}
+				continue
+			}
+
+			if response.Successful {
+				continue
+			}
+
+			reconnect := "retry"
+			interval := 0
+			if response.Advice != nil {
+				reconnect = response.Advice.Reconnect
+				interval = response.Advice.Interval
+			}
+
+			switch {
+			case reconnect == "none":
+				return true, &permanentError{errors.New("streaming: server refused to reconnect: " + response.Error)}
+			case reconnect == "handshake" || strings.HasPrefix(response.Error, "403::"):
+				// The client ID expired or is unknown to the server, e.g. after a server restart.
+				return true, errors.New("streaming: session expired: " + response.Error)
+			}
+
+			select {
+			case <-ctx.Done():
+				return true, ctx.Err()
+			case <-time.After(time.Duration(interval) * time.Millisecond):
+			}
+		}
+	}
+
+}
+
+/*
+ *	setState
+ *	Reports a change of the connection state to the state callback, if set.
+ *	@since	2.0.0
+ */
+func (s *Client) setState(state salesforce.ConnectionState, err error) {
+
+	if s.stateCallback != nil {
+		s.stateCallback(state, err)
+	}
+
+}
+
+/*
+ *	permanentError
+ *	An error that reconnecting would not resolve.
+ *	@since	2.0.0
+ */
+type permanentError struct {
+	err error
+}
+
+/*
+ *	Error
+ *	@since	2.0.0
+ */
+func (e *permanentError) Error() string {
+
+	return e.err.Error()
+
+}
+
+/*
+ *	restoreReplayIds
+ *	Resumes subscriptions after the replay IDs in the replay store, if any.
+ *	@since	2.0.0
+ */
+func (s *Client) restoreReplayIds(ctx context.Context) error {
+
+	if s.replayStore == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for channel, subscription := range s.subscriptions {
+		stored, err := s.replayStore.Get(ctx, channel)
+		if err != nil {
+			return err
+		}
+
+		if stored == nil {
+			continue
+		}
+
+		replayId, err := strconv.ParseInt(string(stored), 10, 64)
+		if err != nil {
+			return errors.New("streaming: invalid replay ID stored for " + channel)
+		}
+
+		subscription.replayId = replayId
+	}
+
+	return nil
+
+}
+
+/*
+ *	handshake
+ *	Obtains a new client ID and subscribes to the registered channels, resuming after the last events handled.
+ *	@since	2.0.0
+ */
+func (s *Client) handshake(ctx context.Context) error {
+
+	responses, err := s.send(ctx, message{
+		Channel:                  "/meta/handshake",
+		Version:                  "1.0",
+		MinimumVersion:           "1.0",
+		SupportedConnectionTypes: []string{"long-polling"},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(responses) == 0 || !responses[0].Successful {
+		return responseError("handshake", responses)
+	}
+
+	s.mu.Lock()
+	s.clientId = responses[0].ClientId
+
+	requests := make([]message, 0, len(s.subscriptions))
+	for channel, subscription := range s.subscriptions {
+		requests = append(requests, message{
+			Channel:      "/meta/subscribe",
+			ClientId:     s.clientId,
+			Subscription: channel,
+			Ext: map[string]interface{}{
+				"replay": map[string]int64{channel: subscription.replayId},
+			},
+		})
+	}
+	s.mu.Unlock()
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	if responses, err = s.send(ctx, requests...); err != nil {
+		return err
+	}
+
+	for _, response := range responses {
+		if response.Channel != "/meta/subscribe" || response.Successful {
+			continue
+		}
+
+		err := errors.New("streaming: subscribe to " + response.Subscription + " failed: " + response.Error)
+		if strings.HasPrefix(response.Error, "403::") {
+			return err
+		}
+		return &permanentError{err}
+	}
+
+	return nil
+
+}
+
+/*
+ *	connect
+ *	Sends a long poll and returns the messages received, including the response to the poll.
+ *	@since	2.0.0
+ */
+func (s *Client) connect(ctx context.Context) ([]message, error) {
+
+	s.mu.Lock()
+	clientId := s.clientId
+	s.mu.Unlock()
+
+	return s.send(ctx, message{
+		Channel:        "/meta/connect",
+		ClientId:       clientId,
+		ConnectionType: "long-polling",
+	})
+
+}
+
+/*
+ *	disconnect
+ *	Ends the CometD session, if any, on a best-effort basis.
+ *	@since	2.0.0
+ */
+func (s *Client) disconnect() {
+
+	s.mu.Lock()
+	clientId := s.clientId
+	s.clientId = ""
+	s.mu.Unlock()
+
+	if clientId == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s.send(ctx, message{Channel: "/meta/disconnect", ClientId: clientId})
+
+}
+
+/*
+ *	deliver
+ *	Passes an event to the handler of its channel, then records its replay ID.
+ *	Events that cannot be decoded end the session with a permanent error.
+ *	@since	2.0.0
+ */
+func (s *Client) deliver(ctx context.Context, response message) error {
+
+	s.mu.Lock()
+	subscription, ok := s.subscriptions[response.Channel]
+	s.mu.Unlock()
+
+	if !ok || response.Data == nil {
+		return nil
+	}
+
+	var data struct {
+		Event struct {
+			ReplayId    int64  `json:"replayId"`
+			CreatedDate string `json:"createdDate"`
+			Type        string `json:"type"`
+		} `json:"event"`
+		SObject json.RawMessage `json:"sobject"`
+		Payload json.RawMessage `json:"payload"`
+	}
+
+	// A malformed event is not dispatched, nor its replay ID recorded; reconnecting would receive it again.
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		return &permanentError{fmt.Errorf("streaming: malformed event on %s: %w", response.Channel, err)}
+	}
+
+	subscription.handler(ctx, Event{
+		Channel:     response.Channel,
+		ReplayId:    data.Event.ReplayId,
+		CreatedDate: data.Event.CreatedDate,
+		Type:        data.Event.Type,
+		SObject:     data.SObject,
+		Payload:     data.Payload,
+		Data:        response.Data,
+	})
+
+	if data.Event.ReplayId == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	subscription.replayId = data.Event.ReplayId
+	s.mu.Unlock()
+
+	if s.replayStore == nil {
+		return nil
+	}
+
+	return s.replayStore.Set(ctx, response.Channel, []byte(strconv.FormatInt(data.Event.ReplayId, 10)))
+
+}
+
+/*
+ *	send
+ *	Posts Bayeux messages to the CometD endpoint and returns the messages received.
+ *	@since	2.0.0
+ */
+func (s *Client) send(ctx context.Context, messages ...message) ([]message, error) {
+
+	body, err := json.Marshal(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.client.NewRequest(ctx, http.MethodPost, "/cometd/"+strings.TrimPrefix(s.client.ApiVersionFor(ctx), "v"), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	// The session is bound to the cookies set by the handshake.
+	endpoint := *request.URL
+	for _, cookie := range s.jar.Cookies(&endpoint) {
+		request.AddCookie(cookie)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	s.jar.SetCookies(&endpoint, response.Cookies())
+
+	var responses []message
+
+	if err := json.NewDecoder(response.Body).Decode(&responses); err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+
+}
+
+/*
+ *	responseError
+ *	Returns the error reported by the response to a meta message.
+ *	@since	2.0.0
+ */
+func responseError(operation string, responses []message) error {
+
+	if len(responses) == 0 {
+		return errors.New("streaming: " + operation + " failed: no response")
+	}
+
+	return errors.New("streaming: " + operation + " failed: " + responses[0].Error)
+
+}
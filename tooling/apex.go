@@ -0,0 +1,78 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package tooling
+
+// Import standard packages.
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+/*
+ *	ExecuteAnonymousResult
+ *	The outcome of executing anonymous Apex.
+ *	Line and Column locate the compile problem or exception, if any.
+ *	@since	2.0.0
+ */
+type ExecuteAnonymousResult struct {
+	Compiled            bool   `json:"compiled"`
+	Success             bool   `json:"success"`
+	Line                int    `json:"line"`
+	Column              int    `json:"column"`
+	CompileProblem      string `json:"compileProblem"`
+	ExceptionMessage    string `json:"exceptionMessage"`
+	ExceptionStackTrace string `json:"exceptionStackTrace"`
+}
+
+/*
+ *	Err
+ *	Returns an error describing the compile problem or exception, or nil if the Apex ran successfully.
+ *	@since	2.0.0
+ */
+func (r *ExecuteAnonymousResult) Err() error {
+
+	switch {
+	case !r.Compiled:
+		return fmt.Errorf("tooling: apex compile error at line %d, column %d: %s", r.Line, r.Column, r.CompileProblem)
+	case !r.Success:
+		return fmt.Errorf("tooling: apex exception at line %d, column %d: %s", r.Line, r.Column, r.ExceptionMessage)
+	}
+
+	return nil
+
+}
+
+/*
+ *	ExecuteAnonymous
+ *	Compiles and executes a block of anonymous Apex as the authenticated user.
+ *	A compile problem or exception is reported by the result, not the returned error; see ExecuteAnonymousResult.Err.
+ *	@since	2.0.0
+ */
+func (t *Client) ExecuteAnonymous(ctx context.Context, apexCode string) (*ExecuteAnonymousResult, error) {
+
+	var result ExecuteAnonymousResult
+
+	if err := t.client.DoJSON(ctx, http.MethodGet, "tooling/executeAnonymous/?anonymousBody="+url.QueryEscape(apexCode), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
@@ -0,0 +1,225 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package tooling
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hannjosh/salesforce-go"
+)
+
+/*
+ *	Client
+ *	A client for the Salesforce Tooling API, which exposes development objects
+ *	such as ApexClass, TraceFlag, and EntityDefinition.
+ *	A Client is safe for concurrent use by multiple goroutines.
+ *	@since	2.0.0
+ */
+type Client struct {
+	// Client of the org, used to send requests.
+	client *salesforce.Client
+}
+
+/*
+ *	NewClient
+ *	Returns a Tooling API client sending requests through the given client,
+ *	with its authentication, API version, and options.
+ *	@since	2.0.0
+ */
+func NewClient(client *salesforce.Client) *Client {
+
+	return &Client{client: client}
+
+}
+
+/*
+ *	Query
+ *	Executes a SOQL query against Tooling API objects and returns the first page of records.
+ *	Use QueryResult.Next to retrieve the remaining pages.
+ *	@since	2.0.0
+ */
+func (t *Client) Query(ctx context.Context, soql string) (*salesforce.QueryResult, error) {
+
+	return t.client.QueryResource(ctx, "tooling/query", soql)
+
+}
+
+/*
+ *	QueryInto
+ *	Executes a SOQL query against Tooling API objects, retrieves all pages of records,
+ *	and decodes each record into a T.
+ *	@since	2.0.0
+ */
+func QueryInto[T any](ctx context.Context, t *Client, soql string) ([]T, error) {
+
+	result, err := t.Query(ctx, soql)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]T, 0, result.TotalSize)
+
+	for {
+		for _, raw := range result.Records {
+			var record T
+			if err := json.Unmarshal(raw, &record); err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+
+		if !result.HasNext() {
+			return records, nil
+		}
+
+		if result, err = result.Next(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+/*
+ *	Create
+ *	Creates a Tooling API record and returns its ID.
+ *	@since	2.0.0
+ */
+func (t *Client) Create(ctx context.Context, object string, data map[string]interface{}) (string, error) {
+
+	var result salesforce.SaveResult
+
+	// 201 Created
+	if err := t.client.DoJSON(ctx, http.MethodPost, "tooling/sobjects/"+object+"/", data, &result); err != nil {
+		return "", err
+	}
+
+	if err := result.Err(); err != nil {
+		return "", err
+	}
+
+	return result.Id, nil
+
+}
+
+/*
+ *	GetRecord
+ *	Returns the JSON representation of a Tooling API record.
+ *	If no fields are given, all fields the user has access to are returned.
+ *	@since	2.0.0
+ */
+func (t *Client) GetRecord(ctx context.Context, object string, id string, fields ...string) ([]byte, error) {
+
+	path := "tooling/sobjects/" + object + "/" + id
+	if len(fields) > 0 {
+		path += "?fields=" + url.QueryEscape(strings.Join(fields, ","))
+	}
+
+	request, err := t.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	return io.ReadAll(response.Body)
+
+}
+
+/*
+ *	GetRecordInto
+ *	Decodes a Tooling API record into v, which must be a pointer.
+ *	If no fields are given, all fields the user has access to are returned.
+ *	@since	2.0.0
+ */
+func (t *Client) GetRecordInto(ctx context.Context, object string, id string, v interface{}, fields ...string) error {
+
+	body, err := t.GetRecord(ctx, object, id, fields...)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+
+}
+
+/*
+ *	Update
+ *	Updates the fields of an existing Tooling API record.
+ *	@since	2.0.0
+ */
+func (t *Client) Update(ctx context.Context, object string, id string, data map[string]interface{}) error {
+
+	return t.client.DoJSON(ctx, http.MethodPatch, "tooling/sobjects/"+object+"/"+id, data, nil)
+
+}
+
+/*
+ *	Delete
+ *	Deletes an existing Tooling API record.
+ *	@since	2.0.0
+ */
+func (t *Client) Delete(ctx context.Context, object string, id string) error {
+
+	return t.client.DoJSON(ctx, http.MethodDelete, "tooling/sobjects/"+object+"/"+id, nil, nil)
+
+}
+
+/*
+ *	DescribeSObject
+ *	Returns the metadata of a Tooling API object.
+ *	@since	2.0.0
+ */
+func (t *Client) DescribeSObject(ctx context.Context, name string) (*salesforce.SObjectDescribe, error) {
+
+	var describe salesforce.SObjectDescribe
+
+	if err := t.client.DoJSON(ctx, http.MethodGet, "tooling/sobjects/"+name+"/describe/", nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
+
+/*
+ *	DescribeGlobal
+ *	Returns the list of Tooling API objects.
+ *	@since	2.0.0
+ */
+func (t *Client) DescribeGlobal(ctx context.Context) (*salesforce.GlobalDescribe, error) {
+
+	var describe salesforce.GlobalDescribe
+
+	if err := t.client.DoJSON(ctx, http.MethodGet, "tooling/sobjects/", nil, &describe); err != nil {
+		return nil, err
+	}
+
+	return &describe, nil
+
+}
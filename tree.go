@@ -0,0 +1,357 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+/*
+ *	TreeRecord
+ *	A record of an sObject Tree, with its child records keyed by relationship name,
+ *	e.g. an Account with Children{"Contacts": ..., "Opportunities": ...}.
+ *	@since	2.0.0
+ */
+type TreeRecord struct {
+	// Object type of the record.
+	Type string
+
+	// Unique reference of the record within the request, returned with its result.
+	ReferenceId string
+
+	Fields map[string]interface{}
+
+	Children map[string][]TreeRecord
+}
+
+/*
+ *	MarshalJSON
+ *	@since	2.0.0
+ */
+func (r TreeRecord) MarshalJSON() ([]byte, error) {
+
+	record := make(map[string]interface{}, len(r.Fields)+len(r.Children)+1)
+
+	for field, value := range r.Fields {
+		record[field] = value
+	}
+
+	for relationship, children := range r.Children {
+		record[relationship] = map[string][]TreeRecord{"records": children}
+	}
+
+	record["attributes"] = map[string]string{
+		"type":        r.Type,
+		"referenceId": r.ReferenceId,
+	}
+
+	return json.Marshal(record)
+
+}
+
+/*
+ *	TreeResult
+ *	The result of creating an sObject Tree.
+ *	If HasErrors is set, no record was created and Results lists the records that failed.
+ *	@since	2.0.0
+ */
+type TreeResult struct {
+	HasErrors bool `json:"hasErrors"`
+	Results   []struct {
+		ReferenceId string            `json:"referenceId"`
+		Id          string            `json:"id"`
+		Errors      []SalesforceError `json:"errors"`
+	} `json:"results"`
+}
+
+/*
+ *	Err
+ *	Returns the errors reported for the records that failed, or nil if the tree was created.
+ *	@since	2.0.0
+ */
+func (r *TreeResult) Err() error {
+
+	var errs []SalesforceError
+	for _, result := range r.Results {
+		errs = append(errs, result.Errors...)
+	}
+
+	return joinErrors(http.StatusBadRequest, errs)
+
+}
+
+/*
+ *	CreateTree
+ *	Creates one or more trees of records of an object, with their children, in a single atomic call.
+ *	Up to 200 records may be created in total, nested up to five levels deep.
+ *	If any record fails, none is created, and the result is returned along with the errors of the records that failed.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateTree(ctx context.Context, object string, records ...TreeRecord) (*TreeResult, error) {
+
+	jsonData, err := json.Marshal(map[string][]TreeRecord{"records": records})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := c.newRequest(ctx, http.MethodPost, c.dataUrl(ctx, "composite/tree/"+object), bytes.NewReader(jsonData))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.send(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	var result TreeResult
+
+	// 400 Bad Request carries the errors of the records that failed.
+	if response.StatusCode == http.StatusBadRequest {
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		if json.Unmarshal(body, &result) == nil && result.HasErrors {
+			if err := result.Err(); err != nil {
+				return &result, err
+			}
+			return &result, decodeError(response.StatusCode, body)
+		}
+		return nil, decodeError(response.StatusCode, body)
+	}
+
+	if err := checkResponse(response); err != nil {
+		return nil, err
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+
+}
+
+/*
+ *	CreateTreeFrom
+ *	Creates trees of records of an object from v, a struct, a pointer to one, or a slice of them,
+ *	as converted by NewTreeRecords.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateTreeFrom(ctx context.Context, object string, v interface{}) (*TreeResult, error) {
+
+	records, err := NewTreeRecords(object, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateTree(ctx, object, records...)
+
+}
+
+/*
+ *	NewTreeRecords
+ *	Converts v, a struct, a pointer to one, or a slice of them, into trees of records of an object.
+ *	Fields are named by their json tags, their sf tags, or their names, and fields tagged omitempty are left out when empty.
+ *	Slices of structs become child records, keyed by the relationship name of the field, e.g. `sf:"Contacts"`;
+ *	their object is given by an object option, e.g. `sf:"Contacts,object=Contact"`, or else the name of the struct type.
+ *	Reference IDs are assigned in order, as ref1, ref2, and so on.
+ *	@since	2.0.0
+ */
+func NewTreeRecords(object string, v interface{}) ([]TreeRecord, error) {
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	var next int
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		record, err := newTreeRecord(object, rv, &next)
+		if err != nil {
+			return nil, err
+		}
+		return []TreeRecord{record}, nil
+	case reflect.Slice, reflect.Array:
+		return newTreeRecordList(object, rv, &next)
+	}
+
+	return nil, fmt.Errorf("salesforce: cannot create a tree from %T", v)
+
+}
+
+/*
+ *	newTreeRecordList
+ *	@since	2.0.0
+ */
+func newTreeRecordList(object string, rv reflect.Value, next *int) ([]TreeRecord, error) {
+
+	records := make([]TreeRecord, 0, rv.Len())
+
+	for i := 0; i < rv.Len(); i++ {
+		item := rv.Index(i)
+		for item.Kind() == reflect.Pointer && !item.IsNil() {
+			item = item.Elem()
+		}
+
+		if item.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("salesforce: cannot create a tree record from %s", item.Type())
+		}
+
+		record, err := newTreeRecord(object, item, next)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+
+}
+
+/*
+ *	newTreeRecord
+ *	@since	2.0.0
+ */
+func newTreeRecord(object string, rv reflect.Value, next *int) (TreeRecord, error) {
+
+	*next++
+
+	record := TreeRecord{
+		Type:        object,
+		ReferenceId: fmt.Sprintf("ref%d", *next),
+		Fields:      make(map[string]interface{}),
+	}
+
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options := treeFieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		value := rv.Field(i)
+
+		if child, ok := treeChildType(field.Type); ok {
+			if value.Len() == 0 {
+				continue
+			}
+
+			childObject := child.Name()
+			for _, option := range options {
+				if o, ok := strings.CutPrefix(option, "object="); ok {
+					childObject = o
+				}
+			}
+
+			children, err := newTreeRecordList(childObject, value, next)
+			if err != nil {
+				return TreeRecord{}, err
+			}
+
+			if record.Children == nil {
+				record.Children = make(map[string][]TreeRecord)
+			}
+			record.Children[name] = children
+			continue
+		}
+
+		if value.IsZero() && slices.Contains(options, "omitempty") {
+			continue
+		}
+
+		record.Fields[name] = value.Interface()
+	}
+
+	return record, nil
+
+}
+
+/*
+ *	treeFieldName
+ *	Returns the name of a field of a tree record and the options of its tags.
+ *	@since	2.0.0
+ */
+func treeFieldName(field reflect.StructField) (string, []string) {
+
+	var options []string
+
+	name := field.Name
+	for _, key := range []string{"sf", "json"} {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+
+		n, rest, _ := strings.Cut(tag, ",")
+		if n != "" {
+			name = n
+		}
+		if rest != "" {
+			options = append(options, strings.Split(rest, ",")...)
+		}
+	}
+
+	return name, options
+
+}
+
+/*
+ *	treeChildType
+ *	Returns the struct type of the child records held by a field of type t, if it holds any.
+ *	@since	2.0.0
+ */
+func treeChildType(t reflect.Type) (reflect.Type, bool) {
+
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	elem := t.Elem()
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+
+	if elem.Kind() != reflect.Struct || elem == reflect.TypeOf(time.Time{}) {
+		return nil, false
+	}
+
+	return elem, true
+
+}
@@ -0,0 +1,134 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+/*
+ *	TestCreateTreeFailure
+ *	A tree that fails to be created must return its result along with the errors of the records that failed.
+ *	@since	2.0.0
+ */
+func TestCreateTreeFailure(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"hasErrors": true, "results": [{"referenceId": "ref2", "errors": [{"statusCode": "REQUIRED_FIELD_MISSING", "message": "Required fields are missing: [LastName]", "fields": ["LastName"]}]}]}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithInstanceUrl(server.URL),
+		WithOAuth2AccessToken("Bearer token"),
+	)
+
+	result, err := client.CreateTree(context.Background(), "Account", TreeRecord{Type: "Account", ReferenceId: "ref1"})
+
+	if result == nil || !result.HasErrors {
+		t.Fatalf("result = %+v, want a result with errors", result)
+	}
+
+	var sfErr *SalesforceError
+	if !errors.As(err, &sfErr) {
+		t.Fatalf("err = %v, want a *SalesforceError", err)
+	}
+
+	if sfErr.ErrorCode != "REQUIRED_FIELD_MISSING" || sfErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("err = %+v, want REQUIRED_FIELD_MISSING with status 400", sfErr)
+	}
+
+}
+
+/*
+ *	TestNewTreeRecords
+ *	@since	2.0.0
+ */
+func TestNewTreeRecords(t *testing.T) {
+
+	type Contact struct {
+		LastName string
+		Email    string `json:"Email,omitempty"`
+	}
+
+	type Account struct {
+		Name          string    `sf:"Name"`
+		Phone         string    `json:"Phone,omitempty"`
+		Ignored       string    `json:"-"`
+		Contacts      []Contact `sf:"Contacts"`
+		Opportunities []struct {
+			Name      string
+			StageName string
+		} `sf:"Opportunities,object=Opportunity"`
+	}
+
+	account := Account{
+		Name:     "Acme",
+		Ignored:  "x",
+		Contacts: []Contact{{LastName: "Smith", Email: "smith@example.com"}, {LastName: "Jones"}},
+		Opportunities: []struct {
+			Name      string
+			StageName string
+		}{{"Deal", "Prospecting"}},
+	}
+
+	records, err := NewTreeRecords("Account", &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := json.Marshal(map[string][]TreeRecord{"records": records})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"records": [{
+		"attributes": {"type": "Account", "referenceId": "ref1"},
+		"Name": "Acme",
+		"Contacts": {"records": [
+			{"attributes": {"type": "Contact", "referenceId": "ref2"}, "LastName": "Smith", "Email": "smith@example.com"},
+			{"attributes": {"type": "Contact", "referenceId": "ref3"}, "LastName": "Jones"}
+		]},
+		"Opportunities": {"records": [
+			{"attributes": {"type": "Opportunity", "referenceId": "ref4"}, "Name": "Deal", "StageName": "Prospecting"}
+		]}
+	}]}`
+
+	var gotValue, wantValue interface{}
+	json.Unmarshal(got, &gotValue)
+	json.Unmarshal([]byte(want), &wantValue)
+
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("records = %s", got)
+	}
+
+	if _, err := NewTreeRecords("Account", "Acme"); err == nil {
+		t.Error("NewTreeRecords(string): err = nil, want an error")
+	}
+
+}
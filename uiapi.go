@@ -0,0 +1,122 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	Id of the master record type, used by objects without record types.
+ *	@since	2.0.0
+ */
+const MasterRecordTypeId string = "012000000000000AAA"
+
+/*
+ *	PicklistFieldValues
+ *	The values of a picklist field available for a record type, as returned by the User Interface API.
+ *	@since	2.0.0
+ */
+type PicklistFieldValues struct {
+	// Index of each value of the controlling field, for dependent picklists.
+	ControllerValues map[string]int `json:"controllerValues"`
+
+	DefaultValue *PicklistEntry  `json:"defaultValue"`
+	Url          string          `json:"url"`
+	Values       []PicklistEntry `json:"values"`
+}
+
+/*
+ *	PicklistEntry
+ *	A value of a picklist field available for a record type.
+ *	@since	2.0.0
+ */
+type PicklistEntry struct {
+	Label      string                 `json:"label"`
+	Value      string                 `json:"value"`
+	Attributes map[string]interface{} `json:"attributes"`
+
+	// Indexes in ControllerValues of the controlling values this value is valid for.
+	ValidFor []int `json:"validFor"`
+}
+
+/*
+ *	DependentValues
+ *	Returns the values valid for the given value of the controlling field.
+ *	@since	2.0.0
+ */
+func (p *PicklistFieldValues) DependentValues(controllerValue string) []PicklistEntry {
+
+	index, ok := p.ControllerValues[controllerValue]
+	if !ok {
+		return nil
+	}
+
+	var values []PicklistEntry
+
+	for _, value := range p.Values {
+		for _, valid := range value.ValidFor {
+			if valid == index {
+				values = append(values, value)
+				break
+			}
+		}
+	}
+
+	return values
+
+}
+
+/*
+ *	GetPicklistValues
+ *	Returns the values of every picklist field of an object available for a record type, keyed by field name.
+ *	Use MasterRecordTypeId for objects without record types.
+ *	@since	2.0.0
+ */
+func (c *Client) GetPicklistValues(ctx context.Context, object string, recordTypeId string) (map[string]PicklistFieldValues, error) {
+
+	var result struct {
+		PicklistFieldValues map[string]PicklistFieldValues `json:"picklistFieldValues"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "ui-api/object-info/"+object+"/picklist-values/"+recordTypeId), nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.PicklistFieldValues, nil
+
+}
+
+/*
+ *	GetFieldPicklistValues
+ *	Returns the values of a picklist field available for a record type.
+ *	@since	2.0.0
+ */
+func (c *Client) GetFieldPicklistValues(ctx context.Context, object string, recordTypeId string, field string) (*PicklistFieldValues, error) {
+
+	var values PicklistFieldValues
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "ui-api/object-info/"+object+"/picklist-values/"+recordTypeId+"/"+field), nil, &values); err != nil {
+		return nil, err
+	}
+
+	return &values, nil
+
+}
@@ -0,0 +1,264 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+/*
+ *	Layout types and modes of the User Interface API, selecting the fields of a record by its page layout.
+ *	@since	2.0.0
+ */
+const (
+	LayoutFull    string = "Full"
+	LayoutCompact string = "Compact"
+
+	ModeView   string = "View"
+	ModeEdit   string = "Edit"
+	ModeCreate string = "Create"
+)
+
+/*
+ *	UiRecord
+ *	A record as returned by the User Interface API, with the display values of its fields.
+ *	Fields the user cannot see are omitted.
+ *	@since	2.0.0
+ */
+type UiRecord struct {
+	ApiName          string `json:"apiName"`
+	Id               string `json:"id"`
+	LastModifiedById string `json:"lastModifiedById"`
+	LastModifiedDate string `json:"lastModifiedDate"`
+	SystemModstamp   string `json:"systemModstamp"`
+
+	RecordTypeId   string          `json:"recordTypeId"`
+	RecordTypeInfo *RecordTypeInfo `json:"recordTypeInfo"`
+
+	// Values of the fields by API name. Relationship fields, e.g. Owner, hold the related record.
+	Fields map[string]UiFieldValue `json:"fields"`
+
+	ChildRelationships map[string]json.RawMessage `json:"childRelationships"`
+
+	// Version of the record, changed by every update.
+	ETag string `json:"eTag"`
+}
+
+/*
+ *	UiFieldValue
+ *	The value of a field of a UiRecord, and its value formatted for display, e.g. in the currency or locale of the user.
+ *	@since	2.0.0
+ */
+type UiFieldValue struct {
+	DisplayValue string
+
+	// Value as decoded from JSON, or a *UiRecord for relationship fields.
+	Value interface{}
+}
+
+/*
+ *	UnmarshalJSON
+ *	Decodes related records into a *UiRecord.
+ *	@since	2.0.0
+ */
+func (v *UiFieldValue) UnmarshalJSON(data []byte) error {
+
+	var raw struct {
+		DisplayValue *string         `json:"displayValue"`
+		Value        json.RawMessage `json:"value"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*v = UiFieldValue{}
+
+	if raw.DisplayValue != nil {
+		v.DisplayValue = *raw.DisplayValue
+	}
+
+	if len(raw.Value) > 0 && raw.Value[0] == '{' {
+		var record UiRecord
+		if err := json.Unmarshal(raw.Value, &record); err != nil {
+			return err
+		}
+		v.Value = &record
+		return nil
+	}
+
+	if len(raw.Value) > 0 {
+		return json.Unmarshal(raw.Value, &v.Value)
+	}
+
+	return nil
+
+}
+
+/*
+ *	Field
+ *	Returns the value of a field, following dotted relationship paths, e.g. Owner.Name.
+ *	Reports false if the field, or a record on its path, was not returned.
+ *	@since	2.0.0
+ */
+func (r *UiRecord) Field(path string) (UiFieldValue, bool) {
+
+	name, rest, nested := strings.Cut(path, ".")
+
+	value, ok := r.Fields[name]
+	if !ok || !nested {
+		return value, ok
+	}
+
+	related, ok := value.Value.(*UiRecord)
+	if !ok {
+		return UiFieldValue{}, false
+	}
+
+	return related.Field(rest)
+
+}
+
+/*
+ *	GetUiRecord
+ *	Returns a record through the User Interface API, with the given fields, qualified by object, e.g. Account.Name.
+ *	Fields the user cannot see are omitted rather than failing the request.
+ *	@since	2.0.0
+ */
+func (c *Client) GetUiRecord(ctx context.Context, id string, fields ...string) (*UiRecord, error) {
+
+	query := url.Values{}
+	query.Set("optionalFields", strings.Join(fields, ","))
+
+	return c.getUiRecord(ctx, id, query)
+
+}
+
+/*
+ *	GetUiRecordLayout
+ *	Returns a record through the User Interface API, with the fields of its page layout for the user,
+ *	e.g. LayoutFull and ModeView, or LayoutCompact and ModeView for the compact layout.
+ *	@since	2.0.0
+ */
+func (c *Client) GetUiRecordLayout(ctx context.Context, id string, layoutType string, mode string) (*UiRecord, error) {
+
+	query := url.Values{}
+	query.Set("layoutTypes", layoutType)
+	query.Set("modes", mode)
+
+	return c.getUiRecord(ctx, id, query)
+
+}
+
+/*
+ *	getUiRecord
+ *	@since	2.0.0
+ */
+func (c *Client) getUiRecord(ctx context.Context, id string, query url.Values) (*UiRecord, error) {
+
+	var record UiRecord
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "ui-api/records/"+id+"?"+query.Encode()), nil, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+
+}
+
+/*
+ *	CreateUiRecord
+ *	Creates a record through the User Interface API, which applies the same validation as the Salesforce UI,
+ *	and returns the record created.
+ *	@since	2.0.0
+ */
+func (c *Client) CreateUiRecord(ctx context.Context, object string, fields map[string]interface{}) (*UiRecord, error) {
+
+	request := map[string]interface{}{
+		"apiName": object,
+		"fields":  fields,
+	}
+
+	var record UiRecord
+
+	// 201 Created
+	if err := c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "ui-api/records"), request, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+
+}
+
+/*
+ *	UpdateUiRecord
+ *	Updates the fields of a record through the User Interface API, and returns the record updated.
+ *	If ifUnmodifiedSince is not zero, e.g. the LastModifiedDate of the record when it was read,
+ *	the update fails with 412 Precondition Failed if the record has been modified since.
+ *	@since	2.0.0
+ */
+func (c *Client) UpdateUiRecord(ctx context.Context, id string, fields map[string]interface{}, ifUnmodifiedSince time.Time) (*UiRecord, error) {
+
+	body, err := json.Marshal(map[string]interface{}{"fields": fields})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := c.newRequest(ctx, http.MethodPatch, c.dataUrl(ctx, "ui-api/records/"+id), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if !ifUnmodifiedSince.IsZero() {
+		request.Header.Set("If-Unmodified-Since", ifUnmodifiedSince.UTC().Format(http.TimeFormat))
+	}
+
+	response, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	var record UiRecord
+
+	if err := json.NewDecoder(response.Body).Decode(&record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+
+}
+
+/*
+ *	DeleteUiRecord
+ *	Deletes a record through the User Interface API.
+ *	@since	2.0.0
+ */
+func (c *Client) DeleteUiRecord(ctx context.Context, id string) error {
+
+	return c.doJSON(ctx, http.MethodDelete, c.dataUrl(ctx, "ui-api/records/"+id), nil, nil)
+
+}
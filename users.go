@@ -0,0 +1,75 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"net/http"
+)
+
+/*
+ *	PasswordExpired
+ *	Reports whether the password of a user has expired.
+ *	@since	2.0.0
+ */
+func (c *Client) PasswordExpired(ctx context.Context, userId string) (bool, error) {
+
+	var status struct {
+		IsExpired bool `json:"isExpired"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodGet, c.dataUrl(ctx, "sobjects/User/"+userId+"/password"), nil, &status); err != nil {
+		return false, err
+	}
+
+	return status.IsExpired, nil
+
+}
+
+/*
+ *	SetPassword
+ *	Sets the password of a user, which must meet the password policies of the org.
+ *	@since	2.0.0
+ */
+func (c *Client) SetPassword(ctx context.Context, userId string, password string) error {
+
+	// 204 No Content
+	return c.doJSON(ctx, http.MethodPost, c.dataUrl(ctx, "sobjects/User/"+userId+"/password"), map[string]string{"NewPassword": password}, nil)
+
+}
+
+/*
+ *	ResetPassword
+ *	Resets the password of a user to a generated password, which is returned.
+ *	The user must change it at the next login.
+ *	@since	2.0.0
+ */
+func (c *Client) ResetPassword(ctx context.Context, userId string) (string, error) {
+
+	var result struct {
+		NewPassword string `json:"NewPassword"`
+	}
+
+	if err := c.doJSON(ctx, http.MethodDelete, c.dataUrl(ctx, "sobjects/User/"+userId+"/password"), nil, &result); err != nil {
+		return "", err
+	}
+
+	return result.NewPassword, nil
+
+}
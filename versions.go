@@ -0,0 +1,147 @@
+/**
+ *	Salesforce
+ *	Copyright (C) 2025  hannjosh
+ *
+ *	This program is free software: you can redistribute it and/or modify
+ *	it under the terms of the GNU General Public License as published by
+ *	the Free Software Foundation, either version 3 of the License, or
+ *	(at your option) any later version.
+ *
+ *	This program is distributed in the hope that it will be useful,
+ *	but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *	GNU General Public License for more details.
+ *
+ *	You should have received a copy of the GNU General Public License
+ *	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+ */
+package salesforce
+
+// Import standard packages.
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+/*
+ *	Version
+ *	A version of the Salesforce REST API supported by the org.
+ *	@since	2.0.0
+ */
+type Version struct {
+	Label   string `json:"label"`
+	Url     string `json:"url"`
+	Version string `json:"version"`
+}
+
+/*
+ *	WithLatestApiVersion
+ *	Makes the client use the newest version of the Salesforce REST API supported by the org,
+ *	negotiated on the first call. If negotiation fails, the configured version is used from then on.
+ *	The callback, if not nil, is called once with the negotiated version, or with the error.
+ *	@since	2.0.0
+ */
+func WithLatestApiVersion(callback func(version string, err error)) Option {
+
+	return func(c *Client) {
+		c.latestApiVersion = true
+		c.negotiateCallback = callback
+	}
+
+}
+
+/*
+ *	ListVersions
+ *	Returns the versions of the Salesforce REST API supported by the org, oldest first.
+ *	@since	2.0.0
+ */
+func (c *Client) ListVersions(ctx context.Context) ([]Version, error) {
+
+	var versions []Version
+
+	if err := c.doJSON(ctx, http.MethodGet, c.baseUrl()+"/services/data/", nil, &versions); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+
+}
+
+/*
+ *	NegotiateApiVersion
+ *	Makes the client use the newest version of the Salesforce REST API supported by the org, and returns it.
+ *	@since	2.0.0
+ */
+func (c *Client) NegotiateApiVersion(ctx context.Context) (string, error) {
+
+	versions, err := c.ListVersions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	latest := ""
+	latestNumber := 0.0
+
+	for _, version := range versions {
+		number, err := strconv.ParseFloat(version.Version, 64)
+		if err == nil && number > latestNumber {
+			latest, latestNumber = "v"+version.Version, number
+		}
+	}
+
+	if latest == "" {
+		return "", errors.New("salesforce: no API versions returned")
+	}
+
+	c.mu.Lock()
+	c.apiVersion = latest
+	c.negotiateAttempted = true
+	c.mu.Unlock()
+
+	return latest, nil
+
+}
+
+/*
+ *	negotiateApiVersionOnce
+ *	Negotiates the API version, unless negotiation has already been attempted.
+ *	A failure is not retried, unless it was caused by the context of the call.
+ *	@since	2.0.0
+ */
+func (c *Client) negotiateApiVersionOnce(ctx context.Context) {
+
+	c.mu.RLock()
+	attempted := c.negotiateAttempted
+	c.mu.RUnlock()
+
+	if attempted {
+		return
+	}
+
+	c.negotiateMu.Lock()
+	defer c.negotiateMu.Unlock()
+
+	c.mu.RLock()
+	attempted = c.negotiateAttempted
+	c.mu.RUnlock()
+
+	if attempted {
+		return
+	}
+
+	version, err := c.NegotiateApiVersion(ctx)
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.negotiateAttempted = true
+	c.mu.Unlock()
+
+	if c.negotiateCallback != nil {
+		c.negotiateCallback(version, err)
+	}
+
+}